@@ -0,0 +1,202 @@
+package contentful
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+// link is the generic Contentful link object used to reference other entities
+type link struct {
+	Sys linkSys `json:"sys"`
+}
+
+type linkSys struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	LinkType string `json:"linkType"`
+}
+
+func newLink(linkType, id string) link {
+	return link{
+		Sys: linkSys{
+			ID:       id,
+			Type:     "Link",
+			LinkType: linkType,
+		},
+	}
+}
+
+// getEnvironment returns the environment configured on the resource, falling
+// back to the environment of the provider.
+func getEnvironment(d *schema.ResourceData, client *contentful.Client) string {
+	if environment, ok := d.GetOk("environment"); ok {
+		return environment.(string)
+	}
+
+	return client.Environment
+}
+
+// newRequest builds a request against the Contentful Management API for the
+// endpoints that are not covered by contentful-go. The body is sent as is when
+// it is an io.Reader and encoded as JSON otherwise.
+func newRequest(client *contentful.Client, method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(client.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Path = path
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	default:
+		bytesArray, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(bytesArray)
+	}
+
+	req, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range client.Headers {
+		req.Header.Set(key, value)
+	}
+
+	return req, nil
+}
+
+// doRequest sends the request and decodes the response into v. Errors are
+// returned as contentful-go errors so they can be handled with parseError.
+func doRequest(req *http.Request, v interface{}) error {
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 400 {
+		if v == nil || res.StatusCode == http.StatusNoContent {
+			return nil
+		}
+
+		return json.NewDecoder(res.Body).Decode(v)
+	}
+
+	var e contentful.ErrorResponse
+	if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
+		return err
+	}
+
+	if e.Sys == nil {
+		return e
+	}
+
+	switch e.Sys.ID {
+	case "NotFound":
+		return contentful.NotFoundError{}
+	case "RateLimitExceeded":
+		waitSeconds, err := strconv.Atoi(res.Header.Get("x-contentful-ratelimit-reset"))
+		if err != nil || req.GetBody == nil && req.Body != nil {
+			return e
+		}
+
+		time.Sleep(time.Second * time.Duration(waitSeconds))
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return err
+			}
+		}
+
+		return doRequest(req, v)
+	default:
+		return e
+	}
+}
+
+// collection is a single page of a Contentful collection response
+type collection struct {
+	Total int               `json:"total"`
+	Skip  int               `json:"skip"`
+	Limit int               `json:"limit"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// getCollection fetches a single page of the collection at the given path
+func getCollection(client *contentful.Client, path string, query url.Values) (*collection, error) {
+	req, err := newRequest(client, http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var col collection
+	if err := doRequest(req, &col); err != nil {
+		return nil, err
+	}
+
+	return &col, nil
+}
+
+// collectionIDs returns the sys.id of all items in the collection page
+func collectionIDs(col *collection) ([]string, error) {
+	var ids []string
+	for _, raw := range col.Items {
+		var item struct {
+			Sys linkSys `json:"sys"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, item.Sys.ID)
+	}
+
+	return ids, nil
+}
+
+// listCollection fetches all items of the collection at the given path,
+// requesting further pages until the total is reached.
+func listCollection(client *contentful.Client, path string, query url.Values) ([]json.RawMessage, error) {
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+
+	if pageQuery.Get("limit") == "" {
+		pageQuery.Set("limit", "100")
+	}
+
+	var items []json.RawMessage
+	for {
+		pageQuery.Set("skip", strconv.Itoa(len(items)))
+
+		col, err := getCollection(client, path, pageQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, col.Items...)
+
+		if len(col.Items) == 0 || len(items) >= col.Total {
+			return items, nil
+		}
+	}
+}
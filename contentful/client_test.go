@@ -0,0 +1,37 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		switch r.URL.Query().Get("skip") {
+		case "0":
+			_, _ = fmt.Fprint(w, `{"total": 3, "items": [{"sys": {"id": "a"}}, {"sys": {"id": "b"}}]}`)
+		case "2":
+			_, _ = fmt.Fprint(w, `{"total": 3, "items": [{"sys": {"id": "c"}}]}`)
+		default:
+			t.Errorf("unexpected skip %s", r.URL.Query().Get("skip"))
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	items, err := listCollection(client, "/spaces/space-id/environments", map[string][]string{"limit": {"2"}})
+	assert.NoError(t, err)
+
+	ids, err := collectionIDs(&collection{Items: items})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
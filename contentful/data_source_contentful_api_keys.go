@@ -0,0 +1,158 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+func dataSourceContentfulAPIKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to read the delivery and preview tokens of existing Contentful API Keys.",
+
+		ReadContext: dataSourceAPIKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"api_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the API key with this ID. Reading fails when it does not exist.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return API keys with this name. Reading fails when none match.",
+			},
+			"api_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_token": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The token for the Content Delivery API.",
+						},
+						"preview_token": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The token for the Content Preview API.",
+						},
+						"environments": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the environments and aliases the API key has access to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAPIKeysRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	apiKeyID := d.Get("api_key_id").(string)
+	name := d.Get("name").(string)
+
+	keys, err := findAPIKeys(client, spaceID, apiKeyID, name)
+	var notFoundError contentful.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		return parseError(err)
+	}
+
+	if len(keys) == 0 && (apiKeyID != "" || name != "") {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "No API key found",
+			Detail:   fmt.Sprintf("Space %s has no API key matching api_key_id %q and name %q.", spaceID, apiKeyID, name),
+		}}
+	}
+
+	apiKeys := []map[string]interface{}{}
+	for _, key := range keys {
+		previewToken, err := getPreviewToken(client, spaceID, key)
+		if err != nil {
+			return parseError(err)
+		}
+
+		apiKeys = append(apiKeys, map[string]interface{}{
+			"id":            key.Sys.ID,
+			"name":          key.Name,
+			"description":   key.Description,
+			"access_token":  key.AccessToken,
+			"preview_token": previewToken,
+			"environments":  key.environmentIDs(),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", spaceID, apiKeyID, name))
+
+	if err = d.Set("api_keys", apiKeys); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+// findAPIKeys fetches the API key when apiKeyID is set, otherwise it lists all
+// API keys of the space. Both are filtered by name when set.
+func findAPIKeys(client *contentful.Client, spaceID, apiKeyID, name string) ([]*apiKey, error) {
+	var keys []*apiKey
+	if apiKeyID != "" {
+		key, err := getAPIKey(client, spaceID, apiKeyID)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	} else {
+		items, err := listCollection(client, fmt.Sprintf("/spaces/%s/api_keys", spaceID), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, raw := range items {
+			var key apiKey
+			if err := json.Unmarshal(raw, &key); err != nil {
+				return nil, err
+			}
+
+			keys = append(keys, &key)
+		}
+	}
+
+	matching := []*apiKey{}
+	for _, key := range keys {
+		if name == "" || key.Name == name {
+			matching = append(matching, key)
+		}
+	}
+
+	return matching, nil
+}
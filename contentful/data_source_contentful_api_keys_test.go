@@ -0,0 +1,125 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceAPIKeysRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/spaces/space-id/api_keys":
+			_, _ = fmt.Fprint(w, `{"total": 2, "items": [
+				{"sys": {"id": "key-1"}, "name": "Website", "accessToken": "delivery-1", "environments": [{"sys": {"id": "master"}}], "preview_api_key": {"sys": {"id": "preview-1"}}},
+				{"sys": {"id": "key-2"}, "name": "App", "accessToken": "delivery-2", "preview_api_key": {"sys": {"id": "preview-2"}}}
+			]}`)
+		case "/spaces/space-id/preview_api_keys/preview-1":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "preview-1"}, "accessToken": "preview-token-1"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	d := schema.TestResourceDataRaw(t, dataSourceContentfulAPIKeys().Schema, map[string]interface{}{
+		"space_id": "space-id",
+		"name":     "Website",
+	})
+
+	diags := dataSourceAPIKeysRead(context.Background(), d, &providerMeta{client: client})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("api_keys.#"))
+	assert.Equal(t, "key-1", d.Get("api_keys.0.id"))
+	assert.Equal(t, "delivery-1", d.Get("api_keys.0.access_token"))
+	assert.Equal(t, "preview-token-1", d.Get("api_keys.0.preview_token"))
+	assert.Equal(t, []interface{}{"master"}, d.Get("api_keys.0.environments"))
+}
+
+func TestDataSourceAPIKeysReadByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/spaces/space-id/api_keys/key-2":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "key-2"}, "name": "App", "accessToken": "delivery-2"}`)
+		case "/spaces/space-id/api_keys/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "NotFound"}, "message": "The resource could not be found."}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceContentfulAPIKeys().Schema, map[string]interface{}{
+		"space_id":   "space-id",
+		"api_key_id": "key-2",
+	})
+
+	diags := dataSourceAPIKeysRead(context.Background(), d, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("api_keys.#"))
+	assert.Equal(t, "delivery-2", d.Get("api_keys.0.access_token"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceContentfulAPIKeys().Schema, map[string]interface{}{
+		"space_id":   "space-id",
+		"api_key_id": "missing",
+	})
+
+	diags = dataSourceAPIKeysRead(context.Background(), d, meta)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "No API key found", diags[0].Summary)
+
+	d = schema.TestResourceDataRaw(t, dataSourceContentfulAPIKeys().Schema, map[string]interface{}{
+		"space_id":   "space-id",
+		"api_key_id": "key-2",
+		"name":       "Website",
+	})
+
+	diags = dataSourceAPIKeysRead(context.Background(), d, meta)
+	assert.True(t, diags.HasError())
+}
+
+func TestAccContentfulAPIKeysDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("apikey-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulAPIKeysDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_api_keys.byname", "api_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.contentful_api_keys.byname", "api_keys.0.id", "contentful_apikey.myapikey", "id"),
+					resource.TestCheckResourceAttrSet("data.contentful_api_keys.byname", "api_keys.0.access_token"),
+					resource.TestCheckResourceAttrSet("data.contentful_api_keys.byname", "api_keys.0.preview_token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContentfulAPIKeysDataSourceConfig(name string) string {
+	return testAccContentfulAPIKeyConfig(name, "description") + fmt.Sprintf(`
+data "contentful_api_keys" "byname" {
+  space_id = "%s"
+  name     = contentful_apikey.myapikey.name
+}
+`, spaceID)
+}
@@ -0,0 +1,211 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+func dataSourceContentfulAsset() *schema.Resource {
+	assetSchema := map[string]*schema.Schema{
+		"space_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"environment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The environment of the asset. Defaults to the environment configured in the provider.",
+		},
+		"asset_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	for key, value := range dataSourceAssetAttributes() {
+		assetSchema[key] = value
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to look up an existing Contentful Asset.",
+
+		ReadContext: dataSourceAssetRead,
+
+		Schema: assetSchema,
+	}
+}
+
+// dataSourceAssetAttributes returns the computed attributes of an asset, which
+// are shared by the contentful_asset and contentful_assets data sources.
+func dataSourceAssetAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"published": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Either `draft`, `published`, `changed` or `archived`.",
+		},
+		"title": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The title of the asset, keyed by locale.",
+		},
+		"description": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The description of the asset, keyed by locale.",
+		},
+		"file": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The file of the asset per locale, ordered by locale.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"locale": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"url": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"file_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"content_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"size": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The size of the file in bytes.",
+					},
+					"width": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The width of the image, 0 for other files.",
+					},
+					"height": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The height of the image, 0 for other files.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAssetRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", spaceID, environment, d.Get("asset_id").(string)), nil, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	var asset contentful.Asset
+	if err = doRequest(req, &asset); err != nil {
+		return parseError(err)
+	}
+
+	d.SetId(asset.Sys.ID)
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	for key, value := range flattenAsset(&asset) {
+		if key == "id" {
+			continue
+		}
+
+		if err = d.Set(key, value); err != nil {
+			return parseError(err)
+		}
+	}
+
+	return nil
+}
+
+// flattenAsset maps the asset onto the attributes of dataSourceAssetAttributes
+// and its ID.
+func flattenAsset(asset *contentful.Asset) map[string]interface{} {
+	title := map[string]string{}
+	description := map[string]string{}
+	files := []map[string]interface{}{}
+
+	if asset.Fields != nil {
+		if asset.Fields.Title != nil {
+			title = asset.Fields.Title
+		}
+
+		if asset.Fields.Description != nil {
+			description = asset.Fields.Description
+		}
+
+		locales := make([]string, 0, len(asset.Fields.File))
+		for locale := range asset.Fields.File {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+
+		for _, locale := range locales {
+			file := asset.Fields.File[locale]
+			if file == nil {
+				continue
+			}
+
+			flattened := map[string]interface{}{
+				"locale":       locale,
+				"url":          file.URL,
+				"file_name":    file.FileName,
+				"content_type": file.ContentType,
+				"size":         0,
+				"width":        0,
+				"height":       0,
+			}
+
+			if file.Details != nil {
+				flattened["size"] = file.Details.Size
+
+				if file.Details.Image != nil {
+					flattened["width"] = file.Details.Image.Width
+					flattened["height"] = file.Details.Image.Height
+				}
+			}
+
+			files = append(files, flattened)
+		}
+	}
+
+	return map[string]interface{}{
+		"id":          asset.Sys.ID,
+		"version":     asset.Sys.Version,
+		"published":   asset.Sys.PublishedAt != "",
+		"status":      entityStatus(asset.Sys),
+		"title":       title,
+		"description": description,
+		"file":        files,
+	}
+}
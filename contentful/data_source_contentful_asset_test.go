@@ -0,0 +1,81 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenAsset(t *testing.T) {
+	flattened := flattenAsset(&contentful.Asset{
+		Sys: &contentful.Sys{ID: "logo", Version: 4, PublishedVersion: 3, PublishedAt: "2024-01-01T00:00:00Z"},
+		Fields: &contentful.AssetFields{
+			Title: map[string]string{"en-US": "Logo"},
+			File: map[string]*contentful.File{
+				"en-US": {
+					URL:         "//images.ctfassets.net/logo.png",
+					FileName:    "logo.png",
+					ContentType: "image/png",
+					Details:     &contentful.FileDetails{Size: 1024, Image: &contentful.ImageFields{Width: 200, Height: 100}},
+				},
+				"de": {
+					URL:         "//assets.ctfassets.net/logo.pdf",
+					FileName:    "logo.pdf",
+					ContentType: "application/pdf",
+					Details:     &contentful.FileDetails{Size: 2048},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, "logo", flattened["id"])
+	assert.Equal(t, "published", flattened["status"])
+	assert.Equal(t, true, flattened["published"])
+	assert.Equal(t, map[string]string{"en-US": "Logo"}, flattened["title"])
+	assert.Equal(t, map[string]string{}, flattened["description"])
+
+	files := flattened["file"].([]map[string]interface{})
+	assert.Len(t, files, 2)
+	assert.Equal(t, "de", files[0]["locale"])
+	assert.Equal(t, 2048, files[0]["size"])
+	assert.Equal(t, 0, files[0]["width"])
+	assert.Equal(t, "en-US", files[1]["locale"])
+	assert.Equal(t, 200, files[1]["width"])
+	assert.Equal(t, 100, files[1]["height"])
+}
+
+func TestAccContentfulAssetDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulAssetDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_asset.myasset", "title.en-US", "Asset title"),
+					resource.TestCheckResourceAttr("data.contentful_asset.myasset", "file.0.locale", "en-US"),
+					resource.TestCheckResourceAttrSet("data.contentful_asset.myasset", "file.0.url"),
+					resource.TestCheckResourceAttrSet("data.contentful_asset.myasset", "file.0.size"),
+					resource.TestCheckTypeSetElemAttr("data.contentful_assets.images", "ids.*", "test_asset"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulAssetDataSourceConfig = testAccContentfulAssetConfig + `
+data "contentful_asset" "myasset" {
+  space_id = "` + spaceID + `"
+  asset_id = contentful_asset.myasset.id
+}
+
+data "contentful_assets" "images" {
+  space_id       = "` + spaceID + `"
+  mimetype_group = "image"
+
+  depends_on = [contentful_asset.myasset]
+}
+`
@@ -0,0 +1,135 @@
+package contentful
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/contentful-go"
+)
+
+func dataSourceContentfulAssets() *schema.Resource {
+	assetSchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	for key, value := range dataSourceAssetAttributes() {
+		assetSchema[key] = value
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to query the assets of an environment.",
+
+		ReadContext: dataSourceAssetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The environment to query. Defaults to the environment configured in the provider.",
+			},
+			"mimetype_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"attachment", "plaintext", "image", "audio", "video", "richtext",
+					"presentation", "spreadsheet", "pdfdocument", "archive", "code", "markup",
+				}, false)),
+				Description: "Only return assets of this MIME type group, e.g. `image`.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return assets with this title.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return assets that have all of these tags applied.",
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"assets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: assetSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAssetsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	query := url.Values{}
+	query.Set("order", "sys.id")
+
+	if group := d.Get("mimetype_group").(string); group != "" {
+		query.Set("mimetype_group", group)
+	}
+
+	if title := d.Get("title").(string); title != "" {
+		query.Set("fields.title", title)
+	}
+
+	if tags := newMetadata(d.Get("tags").(*schema.Set)).tagIDs(); len(tags) > 0 {
+		query.Set("metadata.tags.sys.id[all]", strings.Join(tags, ","))
+	}
+
+	items, err := listCollection(client, fmt.Sprintf("/spaces/%s/environments/%s/assets", spaceID, environment), query)
+	if err != nil {
+		return parseError(err)
+	}
+
+	ids := []string{}
+	assets := []map[string]interface{}{}
+	for _, raw := range items {
+		var asset contentful.Asset
+		if err := json.Unmarshal(raw, &asset); err != nil {
+			return diag.FromErr(err)
+		}
+
+		ids = append(ids, asset.Sys.ID)
+		assets = append(assets, flattenAsset(&asset))
+	}
+
+	checksum := sha256.Sum256([]byte(query.Encode()))
+	d.SetId(fmt.Sprintf("%s:%s:%s", spaceID, environment, hex.EncodeToString(checksum[:8])))
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("ids", ids); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("assets", assets); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contentTypeDefinition keeps the validations of a content type as raw JSON.
+// contentful-go decodes them into typed validations, which drops the ones it
+// does not know.
+type contentTypeDefinition struct {
+	Sys struct {
+		ID      string `json:"id"`
+		Version int    `json:"version"`
+	} `json:"sys"`
+	Name         string                       `json:"name"`
+	Description  string                       `json:"description"`
+	DisplayField string                       `json:"displayField"`
+	Fields       []contentTypeFieldDefinition `json:"fields"`
+}
+
+type contentTypeFieldDefinition struct {
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Type        string                      `json:"type"`
+	LinkType    string                      `json:"linkType"`
+	Items       *contentTypeItemsDefinition `json:"items"`
+	Required    bool                        `json:"required"`
+	Localized   bool                        `json:"localized"`
+	Disabled    bool                        `json:"disabled"`
+	Omitted     bool                        `json:"omitted"`
+	Validations []json.RawMessage           `json:"validations"`
+}
+
+type contentTypeItemsDefinition struct {
+	Type        string            `json:"type"`
+	LinkType    string            `json:"linkType"`
+	Validations []json.RawMessage `json:"validations"`
+}
+
+func dataSourceContentfulContentType() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to read the structure of an existing Contentful Content Type.",
+
+		ReadContext: dataSourceContentTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The environment of the content type. Defaults to the environment configured in the provider.",
+			},
+			"content_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_field": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"field": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"items": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"link_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"validations": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The validations of the items, each encoded as JSON.",
+									},
+								},
+							},
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"localized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"omitted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"validations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The validations of the field, each encoded as JSON.",
+						},
+					},
+				},
+			},
+			"schema_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name, description, display field and fields of the content type as returned by the Content Management API, encoded as JSON.",
+			},
+		},
+	}
+}
+
+func dataSourceContentTypeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+	contentTypeID := d.Get("content_type_id").(string)
+
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, environment, contentTypeID), nil, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	var raw json.RawMessage
+	if err = doRequest(req, &raw); err != nil {
+		return parseError(err)
+	}
+
+	var ct contentTypeDefinition
+	if err = json.Unmarshal(raw, &ct); err != nil {
+		return diag.FromErr(err)
+	}
+
+	schemaJSON, err := contentTypeSchemaJSON(raw)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ct.Sys.ID)
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("version", ct.Sys.Version); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("name", ct.Name); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("description", ct.Description); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("display_field", ct.DisplayField); err != nil {
+		return parseError(err)
+	}
+
+	var fields []map[string]interface{}
+	for _, field := range ct.Fields {
+		items := []interface{}{}
+		if field.Items != nil {
+			items = append(items, map[string]interface{}{
+				"type":        field.Items.Type,
+				"link_type":   field.Items.LinkType,
+				"validations": rawMessagesToStrings(field.Items.Validations),
+			})
+		}
+
+		fields = append(fields, map[string]interface{}{
+			"id":          field.ID,
+			"name":        field.Name,
+			"type":        field.Type,
+			"link_type":   field.LinkType,
+			"items":       items,
+			"required":    field.Required,
+			"localized":   field.Localized,
+			"disabled":    field.Disabled,
+			"omitted":     field.Omitted,
+			"validations": rawMessagesToStrings(field.Validations),
+		})
+	}
+
+	if err = d.Set("field", fields); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("schema_json", schemaJSON); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+// contentTypeSchemaJSON strips the sys properties from the content type, so
+// the JSON only changes when the structure changes.
+func contentTypeSchemaJSON(raw json.RawMessage) (string, error) {
+	var definition map[string]interface{}
+	if err := json.Unmarshal(raw, &definition); err != nil {
+		return "", err
+	}
+
+	delete(definition, "sys")
+
+	encoded, err := json.Marshal(definition)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// rawMessagesToStrings returns the messages as compact JSON strings
+func rawMessagesToStrings(messages []json.RawMessage) []string {
+	values := []string{}
+	for _, message := range messages {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, message); err != nil {
+			values = append(values, string(message))
+			continue
+		}
+
+		values = append(values, compacted.String())
+	}
+
+	return values
+}
@@ -0,0 +1,77 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentTypeSchemaJSON(t *testing.T) {
+	schemaJSON, err := contentTypeSchemaJSON(json.RawMessage(`{
+		"sys": {"id": "article", "version": 3},
+		"name": "Article",
+		"displayField": "title",
+		"fields": [{"id": "title", "type": "Symbol"}]
+	}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "Article", "displayField": "title", "fields": [{"id": "title", "type": "Symbol"}]}`, schemaJSON)
+}
+
+func TestRawMessagesToStrings(t *testing.T) {
+	values := rawMessagesToStrings([]json.RawMessage{
+		json.RawMessage(`{ "size": { "max": 10 } }`),
+		json.RawMessage(`{"unique": true}`),
+	})
+	assert.Equal(t, []string{`{"size":{"max":10}}`, `{"unique":true}`}, values)
+}
+
+func TestAccContentfulContentTypeDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulContentTypeDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_content_type.mycontenttype", "name", "tf_test_data_source"),
+					resource.TestCheckResourceAttr("data.contentful_content_type.mycontenttype", "display_field", "field1"),
+					resource.TestCheckResourceAttr("data.contentful_content_type.mycontenttype", "field.#", "2"),
+					resource.TestCheckResourceAttr("data.contentful_content_type.mycontenttype", "field.1.items.0.link_type", "Entry"),
+					resource.TestCheckResourceAttrSet("data.contentful_content_type.mycontenttype", "schema_json"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulContentTypeDataSourceConfig = `
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "` + spaceID + `"
+  name = "tf_test_data_source"
+  description = "Terraform Acc Test Content Type"
+  display_field = "field1"
+  field {
+    id        = "field1"
+    name      = "Field 1"
+    type      = "Text"
+    required  = true
+  }
+  field {
+    id        = "field2"
+    name      = "Field 2"
+    type      = "Array"
+    items {
+      type      = "Link"
+      link_type = "Entry"
+    }
+  }
+}
+
+data "contentful_content_type" "mycontenttype" {
+  space_id        = "` + spaceID + `"
+  content_type_id = contentful_contenttype.mycontenttype.id
+}
+`
@@ -0,0 +1,246 @@
+package contentful
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+func dataSourceContentfulEntries() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to query the entries of an environment.",
+
+		ReadContext: dataSourceEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The environment to query. Defaults to the environment configured in the provider.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries of this content type. Required when filtering on fields.",
+			},
+			"field_equals": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return entries of which the field equals the value, keyed by field ID.",
+			},
+			"field_in": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return entries of which the field equals one of the comma separated values, keyed by field ID.",
+			},
+			"field_exists": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Only return entries of which the field is set (`true`) or not set (`false`), keyed by field ID.",
+			},
+			"order": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The properties to order the entries by, e.g. `sys.createdAt` or `-fields.title` for descending order.",
+			},
+			"select": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return these properties of the entries, e.g. `fields.title`. The `sys` properties are always returned.",
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"published": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Either `draft`, `published`, `changed` or `archived`.",
+						},
+						"fields": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The localized fields of the entry, encoded as JSON.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEntriesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	query, err := buildEntriesQuery(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items, err := listCollection(client, fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, environment), query)
+	if err != nil {
+		return parseError(err)
+	}
+
+	ids := []string{}
+	entries := []map[string]interface{}{}
+	for _, raw := range items {
+		var entry contentful.Entry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return diag.FromErr(err)
+		}
+
+		fields, err := json.Marshal(entry.Fields)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		contentType := ""
+		if entry.Sys.ContentType != nil && entry.Sys.ContentType.Sys != nil {
+			contentType = entry.Sys.ContentType.Sys.ID
+		}
+
+		ids = append(ids, entry.Sys.ID)
+		entries = append(entries, map[string]interface{}{
+			"id":           entry.Sys.ID,
+			"content_type": contentType,
+			"version":      entry.Sys.Version,
+			"published":    entry.Sys.PublishedAt != "",
+			"status":       entityStatus(entry.Sys),
+			"fields":       string(fields),
+		})
+	}
+
+	checksum := sha256.Sum256([]byte(query.Encode()))
+	d.SetId(fmt.Sprintf("%s:%s:%s", spaceID, environment, hex.EncodeToString(checksum[:8])))
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("ids", ids); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("entries", entries); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+// buildEntriesQuery translates the filters of the data source into the query
+// parameters of the Content Management API.
+func buildEntriesQuery(d *schema.ResourceData) (url.Values, error) {
+	query := url.Values{}
+
+	contentType := d.Get("content_type").(string)
+	if contentType != "" {
+		query.Set("content_type", contentType)
+	}
+
+	fieldEquals := d.Get("field_equals").(map[string]interface{})
+	fieldIn := d.Get("field_in").(map[string]interface{})
+	fieldExists := d.Get("field_exists").(map[string]interface{})
+
+	if contentType == "" && len(fieldEquals)+len(fieldIn)+len(fieldExists) > 0 {
+		return nil, errors.New("content_type must be set to filter on fields")
+	}
+
+	for field, value := range fieldEquals {
+		query.Set("fields."+field, value.(string))
+	}
+
+	for field, values := range fieldIn {
+		query.Set(fmt.Sprintf("fields.%s[in]", field), values.(string))
+	}
+
+	for field, exists := range fieldExists {
+		query.Set(fmt.Sprintf("fields.%s[exists]", field), strconv.FormatBool(exists.(bool)))
+	}
+
+	var order []string
+	for _, property := range d.Get("order").([]interface{}) {
+		order = append(order, property.(string))
+	}
+
+	if len(order) > 0 {
+		query.Set("order", strings.Join(order, ","))
+	} else {
+		// A stable order keeps pages from overlapping
+		query.Set("order", "sys.id")
+	}
+
+	var selection []string
+	for _, property := range d.Get("select").([]interface{}) {
+		selection = append(selection, property.(string))
+	}
+
+	if len(selection) > 0 {
+		selection = append(selection, "sys")
+		sort.Strings(selection)
+		query.Set("select", strings.Join(selection, ","))
+	}
+
+	return query, nil
+}
+
+// entityStatus derives the status of an entry or asset the way the web app
+// shows it.
+func entityStatus(sys *contentful.Sys) string {
+	switch {
+	case sys.ArchivedAt != "":
+		return "archived"
+	case sys.PublishedVersion == 0:
+		return "draft"
+	case sys.Version == sys.PublishedVersion+1:
+		return "published"
+	default:
+		return "changed"
+	}
+}
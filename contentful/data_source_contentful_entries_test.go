@@ -0,0 +1,75 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildEntriesQuery(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceContentfulEntries().Schema, map[string]interface{}{
+		"space_id":     "space-id",
+		"content_type": "page",
+		"field_equals": map[string]interface{}{"slug": "home"},
+		"field_in":     map[string]interface{}{"category": "news,blog"},
+		"field_exists": map[string]interface{}{"hero": true},
+		"select":       []interface{}{"fields.title"},
+	})
+
+	query, err := buildEntriesQuery(d)
+	assert.NoError(t, err)
+	assert.Equal(t, "page", query.Get("content_type"))
+	assert.Equal(t, "home", query.Get("fields.slug"))
+	assert.Equal(t, "news,blog", query.Get("fields.category[in]"))
+	assert.Equal(t, "true", query.Get("fields.hero[exists]"))
+	assert.Equal(t, "sys.id", query.Get("order"))
+	assert.Equal(t, "fields.title,sys", query.Get("select"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceContentfulEntries().Schema, map[string]interface{}{
+		"space_id":     "space-id",
+		"field_equals": map[string]interface{}{"slug": "home"},
+	})
+
+	_, err = buildEntriesQuery(d)
+	assert.Error(t, err)
+}
+
+func TestEntityStatus(t *testing.T) {
+	assert.Equal(t, "draft", entityStatus(&contentful.Sys{Version: 3}))
+	assert.Equal(t, "published", entityStatus(&contentful.Sys{Version: 4, PublishedVersion: 3}))
+	assert.Equal(t, "changed", entityStatus(&contentful.Sys{Version: 6, PublishedVersion: 3}))
+	assert.Equal(t, "archived", entityStatus(&contentful.Sys{Version: 6, ArchivedAt: "2024-01-01T00:00:00Z"}))
+}
+
+func TestAccContentfulEntriesDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEntriesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_entries.hello", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.contentful_entries.hello", "entries.0.id", "mytestentry"),
+					resource.TestCheckResourceAttr("data.contentful_entries.hello", "entries.0.status", "published"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulEntriesDataSourceConfig = testAccContentfulEntryConfig + `
+data "contentful_entries" "hello" {
+  space_id     = "` + spaceID + `"
+  content_type = "tf_test_1"
+  field_equals = {
+    field1 = "Hello, World!"
+  }
+
+  depends_on = [contentful_entry.myentry]
+}
+`
@@ -0,0 +1,76 @@
+package contentful
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceContentfulEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to look up an existing Contentful Environment.",
+
+		ReadContext: dataSourceEnvironmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Either `queued`, `ready` or `failed`.",
+			},
+			"aliases": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the aliases that point to the environment.",
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	environment, err := getEnvironmentDetails(client, d.Get("space_id").(string), d.Get("environment_id").(string))
+	if err != nil {
+		return parseError(err)
+	}
+
+	// Requesting an alias returns the environment it points to
+	d.SetId(environment.Sys.ID)
+
+	if err = d.Set("version", environment.Sys.Version); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("name", environment.Name); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("status", environment.status()); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("aliases", environment.aliasIDs()); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContentfulEnvironmentDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEnvironmentDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_environment.master", "name", "master"),
+					resource.TestCheckResourceAttr("data.contentful_environment.master", "status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulEnvironmentDataSourceConfig = `
+data "contentful_environment" "master" {
+  space_id       = "` + spaceID + `"
+  environment_id = "master"
+}
+`
@@ -0,0 +1,107 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceContentfulEnvironments() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the environments of a space.",
+
+		ReadContext: dataSourceEnvironmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Either `queued`, `ready` or `failed`.",
+						},
+						"aliases": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the aliases that point to the environment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+
+	items, err := listCollection(client, fmt.Sprintf("/spaces/%s/environments", spaceID), nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	ids := []string{}
+	environments := []map[string]interface{}{}
+	for _, raw := range items {
+		var environment environmentDetails
+		if err := json.Unmarshal(raw, &environment); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Aliases are listed next to the environments, they show up in the
+		// aliases of the environment they point to instead
+		if environment.Sys.AliasedEnvironment != nil {
+			continue
+		}
+
+		ids = append(ids, environment.Sys.ID)
+		environments = append(environments, map[string]interface{}{
+			"id":      environment.Sys.ID,
+			"version": environment.Sys.Version,
+			"name":    environment.Name,
+			"status":  environment.status(),
+			"aliases": environment.aliasIDs(),
+		})
+	}
+
+	d.SetId(spaceID)
+
+	if err = d.Set("ids", ids); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("environments", environments); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
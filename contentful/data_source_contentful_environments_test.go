@@ -0,0 +1,65 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceEnvironmentsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/spaces/space-id/environments", r.URL.Path)
+		_, _ = fmt.Fprint(w, `{"total": 3, "items": [
+			{"name": "main", "sys": {"id": "main", "version": 2, "status": {"sys": {"id": "ready"}}, "aliases": [{"sys": {"id": "master"}}]}},
+			{"name": "main", "sys": {"id": "master", "aliasedEnvironment": {"sys": {"id": "main"}}}},
+			{"name": "feature-1", "sys": {"id": "feature-1", "version": 1, "status": {"sys": {"id": "queued"}}}}
+		]}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	d := schema.TestResourceDataRaw(t, dataSourceContentfulEnvironments().Schema, map[string]interface{}{
+		"space_id": "space-id",
+	})
+
+	diags := dataSourceEnvironmentsRead(context.Background(), d, &providerMeta{client: client})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"main", "feature-1"}, d.Get("ids"))
+	assert.Equal(t, "ready", d.Get("environments.0.status"))
+	assert.Equal(t, []interface{}{"master"}, d.Get("environments.0.aliases"))
+	assert.Equal(t, "queued", d.Get("environments.1.status"))
+}
+
+func TestAccContentfulEnvironmentsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEnvironmentsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.contentful_environments.all", "ids.*", "master"),
+					resource.TestCheckTypeSetElemAttr("data.contentful_environments.all", "ids.*", "provider-test"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulEnvironmentsDataSourceConfig = testAccContentfulEnvironmentConfig + `
+data "contentful_environments" "all" {
+  space_id = "` + spaceID + `"
+
+  depends_on = [contentful_environment.myenvironment]
+}
+`
@@ -0,0 +1,143 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+func dataSourceContentfulLocales() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the locales of an environment.",
+
+		ReadContext: dataSourceLocalesRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The environment to read the locales from. Defaults to the environment configured in the provider.",
+			},
+			"default_locale": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The code of the default locale.",
+			},
+			"locales": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fallback_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"optional": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cda": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cma": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLocalesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	locales, err := listLocales(client, spaceID, environment)
+	if err != nil {
+		return parseError(err)
+	}
+
+	defaultLocale := ""
+	var flattened []map[string]interface{}
+	for _, locale := range locales {
+		if locale.Default {
+			defaultLocale = locale.Code
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":            locale.Sys.ID,
+			"name":          locale.Name,
+			"code":          locale.Code,
+			"fallback_code": locale.FallbackCode,
+			"optional":      locale.Optional,
+			"default":       locale.Default,
+			"cda":           locale.CDA,
+			"cma":           locale.CMA,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", spaceID, environment))
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("default_locale", defaultLocale); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("locales", flattened); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+// listLocales returns all locales of the environment
+func listLocales(client *contentful.Client, spaceID, environment string) ([]*contentful.Locale, error) {
+	items, err := listCollection(client, fmt.Sprintf("/spaces/%s/environments/%s/locales", spaceID, environment), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locales []*contentful.Locale
+	for _, raw := range items {
+		var locale contentful.Locale
+		if err := json.Unmarshal(raw, &locale); err != nil {
+			return nil, err
+		}
+
+		locales = append(locales, &locale)
+	}
+
+	return locales, nil
+}
@@ -0,0 +1,63 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocales(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/spaces/space-id/environments/staging/locales", r.URL.Path)
+		_, _ = fmt.Fprint(w, `{"total": 2, "items": [
+			{"sys": {"id": "1"}, "name": "English", "code": "en-US", "default": true, "contentDeliveryApi": true, "contentManagementApi": true},
+			{"sys": {"id": "2"}, "name": "German", "code": "de", "fallbackCode": "en-US", "optional": true, "contentDeliveryApi": true, "contentManagementApi": true}
+		]}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	locales, err := listLocales(client, "space-id", "staging")
+	assert.NoError(t, err)
+	assert.Len(t, locales, 2)
+	assert.True(t, locales[0].Default)
+	assert.Equal(t, "de", locales[1].Code)
+	assert.Equal(t, "en-US", locales[1].FallbackCode)
+	assert.True(t, locales[1].Optional)
+}
+
+func TestAccContentfulLocalesDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulLocaleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulLocalesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.contentful_locales.all", "default_locale"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.contentful_locales.all", "locales.*", map[string]string{
+						"code":          "de",
+						"fallback_code": "en-US",
+						"cma":           "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulLocalesDataSourceConfig = testAccContentfulLocaleConfig + `
+data "contentful_locales" "all" {
+  space_id = "` + spaceID + `"
+
+  depends_on = [contentful_locale.mylocale]
+}
+`
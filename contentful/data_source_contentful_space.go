@@ -0,0 +1,120 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceContentfulSpace() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to look up an existing Contentful Space.",
+
+		ReadContext: dataSourceSpaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The environment of which `locales` and `default_locale` are returned. Defaults to the environment configured in the provider.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the environments of the space, without aliases.",
+			},
+			"locales": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The codes of the locales of the environment.",
+			},
+		},
+	}
+}
+
+func dataSourceSpaceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	space, err := client.Spaces.Get(spaceID)
+	if err != nil {
+		return parseError(err)
+	}
+
+	environments, err := listCollection(client, fmt.Sprintf("/spaces/%s/environments", spaceID), nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	environmentIDs := []string{}
+	for _, raw := range environments {
+		var details environmentDetails
+		if err := json.Unmarshal(raw, &details); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Aliases are listed next to the environments, as in contentful_environments
+		if details.Sys.AliasedEnvironment != nil {
+			continue
+		}
+
+		environmentIDs = append(environmentIDs, details.Sys.ID)
+	}
+
+	locales, err := listLocales(client, spaceID, environment)
+	if err != nil {
+		return parseError(err)
+	}
+
+	defaultLocale := space.DefaultLocale
+	codes := []string{}
+	for _, locale := range locales {
+		codes = append(codes, locale.Code)
+		if locale.Default {
+			defaultLocale = locale.Code
+		}
+	}
+
+	d.SetId(spaceID)
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("name", space.Name); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("default_locale", defaultLocale); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("environments", environmentIDs); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("locales", codes); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSpaceRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/spaces/space-id":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "space-id"}, "name": "Playground"}`)
+		case "/spaces/space-id/environments":
+			_, _ = fmt.Fprint(w, `{"total": 3, "items": [
+				{"name": "main", "sys": {"id": "main", "aliases": [{"sys": {"id": "master"}}]}},
+				{"name": "main", "sys": {"id": "master", "aliasedEnvironment": {"sys": {"id": "main"}}}},
+				{"name": "feature-1", "sys": {"id": "feature-1"}}
+			]}`)
+		case "/spaces/space-id/environments/master/locales":
+			_, _ = fmt.Fprint(w, `{"total": 2, "items": [
+				{"sys": {"id": "1"}, "code": "en-US", "default": true},
+				{"sys": {"id": "2"}, "code": "de"}
+			]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	client.SetEnvironment("master")
+
+	d := schema.TestResourceDataRaw(t, dataSourceContentfulSpace().Schema, map[string]interface{}{
+		"space_id": "space-id",
+	})
+
+	diags := dataSourceSpaceRead(context.Background(), d, &providerMeta{client: client})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Playground", d.Get("name"))
+	assert.Equal(t, []interface{}{"main", "feature-1"}, d.Get("environments"))
+	assert.Equal(t, []interface{}{"en-US", "de"}, d.Get("locales"))
+	assert.Equal(t, "en-US", d.Get("default_locale"))
+}
+
+func TestAccContentfulSpaceDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulSpaceDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.contentful_space.myspace", "id", spaceID),
+					resource.TestCheckResourceAttrSet("data.contentful_space.myspace", "name"),
+					resource.TestCheckResourceAttrSet("data.contentful_space.myspace", "default_locale"),
+					resource.TestCheckTypeSetElemAttr("data.contentful_space.myspace", "environments.*", "master"),
+				),
+			},
+		},
+	})
+}
+
+var testAccContentfulSpaceDataSourceConfig = `
+data "contentful_space" "myspace" {
+  space_id = "` + spaceID + `"
+}
+`
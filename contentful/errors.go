@@ -20,6 +20,10 @@ func parseError(err error) diag.Diagnostics {
 	}
 
 	var diags diag.Diagnostics
+	if contentfulErr.Details == nil {
+		contentfulErr.Details = &contentful.ErrorDetails{}
+	}
+
 	for _, e := range contentfulErr.Details.Errors {
 		var path []string
 		if e.Path != nil {
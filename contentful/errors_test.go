@@ -29,6 +29,16 @@ func TestParseError_WithoutWarning(t *testing.T) {
 	assert.Equal(t, d[0].Severity, diag.Error)
 }
 
+func TestParseError_WithoutDetails(t *testing.T) {
+	d := parseError(contentful.ErrorResponse{
+		Message: "error message",
+	})
+	assert.True(t, d.HasError())
+	assert.Equal(t, len(d), 1)
+	assert.Equal(t, d[0].Summary, "error message")
+	assert.Equal(t, d[0].Severity, diag.Error)
+}
+
 func TestParseError_WithWarning_WithoutPath(t *testing.T) {
 	d := parseError(contentful.ErrorResponse{
 		Message: "error message",
@@ -0,0 +1,112 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+// metadata holds the tags of an entry or asset, which contentful-go does not
+// support.
+type metadata struct {
+	Tags []link `json:"tags"`
+}
+
+func newMetadata(tags *schema.Set) *metadata {
+	m := &metadata{Tags: []link{}}
+
+	for _, tag := range tags.List() {
+		m.Tags = append(m.Tags, newLink("Tag", tag.(string)))
+	}
+
+	return m
+}
+
+func (m *metadata) tagIDs() []string {
+	ids := []string{}
+	if m == nil {
+		return ids
+	}
+
+	for _, tag := range m.Tags {
+		ids = append(ids, tag.Sys.ID)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// upsertEntryWithMetadata creates or updates the entry including its metadata.
+// It mirrors EntriesService.Upsert of contentful-go.
+func upsertEntryWithMetadata(client *contentful.Client, spaceID, contentTypeID string, entry *contentful.Entry, meta *metadata) error {
+	body := struct {
+		Fields   map[string]interface{} `json:"fields"`
+		Metadata *metadata              `json:"metadata"`
+	}{
+		Fields:   entry.Fields,
+		Metadata: meta,
+	}
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/entries/%s", spaceID, entry.Sys.ID), nil, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Content-Type", contentTypeID)
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.GetVersion()))
+
+	return doRequest(req, entry)
+}
+
+// upsertAssetWithMetadata creates or updates the asset including its metadata.
+// It mirrors AssetsService.Upsert of contentful-go.
+func upsertAssetWithMetadata(client *contentful.Client, spaceID string, asset *contentful.Asset, meta *metadata) error {
+	body := struct {
+		Fields   *contentful.AssetFields `json:"fields"`
+		Metadata *metadata               `json:"metadata"`
+	}{
+		Fields:   asset.Fields,
+		Metadata: meta,
+	}
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID), nil, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(asset.GetVersion()))
+
+	return doRequest(req, asset)
+}
+
+// getEntityWithMetadata decodes the entry or asset at the given path into
+// entity and returns its metadata from the same response.
+func getEntityWithMetadata(client *contentful.Client, path string, entity interface{}) (*metadata, error) {
+	req, err := newRequest(client, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw json.RawMessage
+	if err := doRequest(req, &raw); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, entity); err != nil {
+		return nil, err
+	}
+
+	var withMetadata struct {
+		Metadata *metadata `json:"metadata"`
+	}
+	if err := json.Unmarshal(raw, &withMetadata); err != nil {
+		return nil, err
+	}
+
+	return withMetadata.Metadata, nil
+}
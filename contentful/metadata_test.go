@@ -0,0 +1,81 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata_TagIDs(t *testing.T) {
+	m := newMetadata(schema.NewSet(schema.HashString, []interface{}{"b", "a"}))
+	assert.Len(t, m.Tags, 2)
+	assert.Equal(t, "Link", m.Tags[0].Sys.Type)
+	assert.Equal(t, "Tag", m.Tags[0].Sys.LinkType)
+	assert.Equal(t, []string{"a", "b"}, m.tagIDs())
+
+	var empty *metadata
+	assert.Equal(t, []string{}, empty.tagIDs())
+}
+
+func TestUpsertEntryWithMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/spaces/space-id/entries/entry-id", r.URL.Path)
+		assert.Equal(t, "page", r.Header.Get("X-Contentful-Content-Type"))
+		assert.Equal(t, "3", r.Header.Get("X-Contentful-Version"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"tags": []interface{}{
+				map[string]interface{}{"sys": map[string]interface{}{"id": "news", "type": "Link", "linkType": "Tag"}},
+			},
+		}, body["metadata"])
+
+		_, _ = fmt.Fprint(w, `{"sys": {"id": "entry-id", "version": 4}, "fields": {}}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	entry := &contentful.Entry{
+		Sys:    &contentful.Sys{ID: "entry-id", Version: 3},
+		Fields: map[string]interface{}{},
+	}
+
+	err := upsertEntryWithMetadata(client, "space-id", "page", entry, newMetadata(schema.NewSet(schema.HashString, []interface{}{"news"})))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, entry.Sys.Version)
+}
+
+func TestGetEntityWithMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/spaces/space-id/assets/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"sys": {"type": "Error", "id": "NotFound"}, "message": "not found"}`)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"sys": {"id": "asset-id"}, "metadata": {"tags": [{"sys": {"id": "news", "type": "Link", "linkType": "Tag"}}]}}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	var asset contentful.Asset
+	meta, err := getEntityWithMetadata(client, "/spaces/space-id/assets/asset-id", &asset)
+	assert.NoError(t, err)
+	assert.Equal(t, "asset-id", asset.Sys.ID)
+	assert.Equal(t, []string{"news"}, meta.tagIDs())
+
+	_, err = getEntityWithMetadata(client, "/spaces/space-id/assets/missing", &asset)
+	assert.ErrorAs(t, err, &contentful.NotFoundError{})
+}
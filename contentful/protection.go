@@ -0,0 +1,64 @@
+package contentful
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultProtectedEnvironments is used when the provider does not configure
+// protected_environments.
+var defaultProtectedEnvironments = []string{"master"}
+
+// checkEnvironmentProtection returns an error diagnostic when the environment,
+// or one of the aliases pointing to it, is listed in protected_environments.
+func checkEnvironmentProtection(protectedEnvironments []string, environmentID string, aliases []string) diag.Diagnostics {
+	for _, protected := range protectedEnvironments {
+		if protected == environmentID {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Environment %s is protected from deletion", environmentID),
+				Detail:   "Remove the environment from protected_environments in the provider configuration before deleting it.",
+			}}
+		}
+
+		for _, alias := range aliases {
+			if protected == alias {
+				return diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Environment %s is protected from deletion", environmentID),
+					Detail:   fmt.Sprintf("The environment is the target of the protected alias %s. Point the alias to another environment or remove it from protected_environments in the provider configuration before deleting the environment.", alias),
+				}}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkDeletionProtection returns an error diagnostic when deletion_protection
+// is enabled on the resource.
+func checkDeletionProtection(resourceType, id string, enabled bool) diag.Diagnostics {
+	if !enabled {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("The %s %s is protected from deletion", resourceType, id),
+		Detail:   "Set deletion_protection to false and apply the change before deleting it.",
+	}}
+}
+
+// setDeletionProtectionDefault sets deletion_protection to its default when it
+// is missing from the state. Defaults are only applied through a plan, so the
+// attribute is missing after an import and in states written before it existed.
+func setDeletionProtectionDefault(d *schema.ResourceData, value bool) error {
+	raw := d.GetRawState()
+	if !raw.IsNull() && raw.IsKnown() && !raw.GetAttr("deletion_protection").IsNull() {
+		return nil
+	}
+
+	return d.Set("deletion_protection", value)
+}
@@ -0,0 +1,50 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckEnvironmentProtection(t *testing.T) {
+	diags := checkEnvironmentProtection(defaultProtectedEnvironments, "master", nil)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Environment master is protected from deletion", diags[0].Summary)
+
+	diags = checkEnvironmentProtection(defaultProtectedEnvironments, "release-1", []string{"master"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "protected alias master")
+
+	assert.False(t, checkEnvironmentProtection(defaultProtectedEnvironments, "feature", []string{"preview"}).HasError())
+
+	assert.False(t, checkEnvironmentProtection([]string{}, "master", nil).HasError())
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	diags := checkDeletionProtection("space", "space-id", true)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "The space space-id is protected from deletion", diags[0].Summary)
+
+	assert.False(t, checkDeletionProtection("space", "space-id", false).HasError())
+}
+
+func TestSetDeletionProtectionDefault(t *testing.T) {
+	// Imported, or written before deletion_protection existed
+	d := resourceContentfulSpace().TestResourceData()
+	d.SetId("space-id")
+
+	assert.NoError(t, setDeletionProtectionDefault(d, true))
+	assert.Equal(t, true, d.Get("deletion_protection"))
+
+	// Explicitly disabled
+	d = resourceContentfulSpace().Data(&terraform.InstanceState{
+		ID:         "space-id",
+		Attributes: map[string]string{"id": "space-id", "deletion_protection": "false"},
+		RawState:   cty.ObjectVal(map[string]cty.Value{"deletion_protection": cty.False}),
+	})
+
+	assert.NoError(t, setDeletionProtectionDefault(d, true))
+	assert.Equal(t, false, d.Get("deletion_protection"))
+}
@@ -35,21 +35,50 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_ENVIRONMENT", "master"),
 				Description: "The environment to use for the Contentful API. Defaults to master",
 			},
+			"protected_environments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The environments and aliases that can not be deleted by contentful_environment. Defaults to [\"master\"], set it to an empty list to allow deleting any environment.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"contentful_space":       resourceContentfulSpace(),
-			"contentful_contenttype": resourceContentfulContentType(),
-			"contentful_apikey":      resourceContentfulAPIKey(),
-			"contentful_webhook":     resourceContentfulWebhook(),
-			"contentful_locale":      resourceContentfulLocale(),
-			"contentful_environment": resourceContentfulEnvironment(),
-			"contentful_entry":       resourceContentfulEntry(),
-			"contentful_asset":       resourceContentfulAsset(),
+			"contentful_space":             resourceContentfulSpace(),
+			"contentful_contenttype":       resourceContentfulContentType(),
+			"contentful_apikey":            resourceContentfulAPIKey(),
+			"contentful_webhook":           resourceContentfulWebhook(),
+			"contentful_locale":            resourceContentfulLocale(),
+			"contentful_environment":       resourceContentfulEnvironment(),
+			"contentful_environment_alias": resourceContentfulEnvironmentAlias(),
+			"contentful_entry":             resourceContentfulEntry(),
+			"contentful_entries":           resourceContentfulEntries(),
+			"contentful_asset":             resourceContentfulAsset(),
+			"contentful_scheduled_action":  resourceContentfulScheduledAction(),
+			"contentful_release":           resourceContentfulRelease(),
+			"contentful_tag":               resourceContentfulTag(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"contentful_space":        dataSourceContentfulSpace(),
+			"contentful_content_type": dataSourceContentfulContentType(),
+			"contentful_entries":      dataSourceContentfulEntries(),
+			"contentful_asset":        dataSourceContentfulAsset(),
+			"contentful_assets":       dataSourceContentfulAssets(),
+			"contentful_locales":      dataSourceContentfulLocales(),
+			"contentful_environment":  dataSourceContentfulEnvironment(),
+			"contentful_environments": dataSourceContentfulEnvironments(),
+			"contentful_api_keys":     dataSourceContentfulAPIKeys(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
+// providerMeta is passed to the resources and data sources as the configured
+// provider.
+type providerMeta struct {
+	client                *contentful.Client
+	protectedEnvironments []string
+}
+
 // providerConfigure sets the configuration for the Terraform Provider
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	cma := contentful.NewCMA(d.Get("cma_token").(string))
@@ -61,5 +90,27 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		cma.Debug = true
 	}
 
-	return cma, nil
+	return &providerMeta{
+		client:                cma,
+		protectedEnvironments: configuredProtectedEnvironments(d),
+	}, nil
+}
+
+// configuredProtectedEnvironments returns the protected_environments of the
+// provider. The raw config is used to tell an empty list, which disables the
+// protection, apart from a list that was not set at all.
+func configuredProtectedEnvironments(d *schema.ResourceData) []string {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || raw.GetAttr("protected_environments").IsNull() {
+		if _, ok := d.GetOk("protected_environments"); !ok {
+			return defaultProtectedEnvironments
+		}
+	}
+
+	protected := []string{}
+	for _, environment := range d.Get("protected_environments").([]interface{}) {
+		protected = append(protected, environment.(string))
+	}
+
+	return protected
 }
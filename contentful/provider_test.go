@@ -1,9 +1,13 @@
 package contentful
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -26,6 +30,37 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProviderConfigureProtectedEnvironments(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		protected cty.Value
+		expected  []string
+	}{
+		{"default", cty.NullVal(cty.List(cty.String)), []string{"master"}},
+		{"configured", cty.ListVal([]cty.Value{cty.StringVal("master"), cty.StringVal("production")}), []string{"master", "production"}},
+		{"disabled", cty.ListValEmpty(cty.String), []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := Provider()
+
+			config := cty.ObjectVal(map[string]cty.Value{
+				"cma_token":              cty.StringVal("token"),
+				"organization_id":        cty.StringVal("organization-id"),
+				"base_url":               cty.NullVal(cty.String),
+				"environment":            cty.NullVal(cty.String),
+				"protected_environments": tc.protected,
+			})
+
+			resourceConfig := terraform.NewResourceConfigShimmed(config, schema.InternalMap(p.Schema).CoreConfigSchema())
+			resourceConfig.CtyValue = config
+
+			diags := p.Configure(context.Background(), resourceConfig)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, p.Meta().(*providerMeta).protectedEnvironments)
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	var cmaToken, organizationID, sId string
 	if cmaToken = CMAToken; cmaToken == "" {
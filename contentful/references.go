@@ -0,0 +1,45 @@
+package contentful
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/labd/contentful-go"
+)
+
+// checkIncomingLinks returns an error diagnostic when entries still link to
+// the entry or asset, since removing it would break them. linkType is either
+// Entry or Asset.
+func checkIncomingLinks(client *contentful.Client, spaceID, linkType, id string) diag.Diagnostics {
+	query := url.Values{}
+	query.Set(fmt.Sprintf("links_to_%s", strings.ToLower(linkType)), id)
+	query.Set("select", "sys.id")
+	query.Set("limit", "100")
+
+	col, err := getCollection(client, fmt.Sprintf("/spaces/%s/entries", spaceID), query)
+	if err != nil {
+		return parseError(err)
+	}
+
+	if col.Total == 0 {
+		return nil
+	}
+
+	ids, err := collectionIDs(col)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	detail := strings.Join(ids, ", ")
+	if col.Total > len(ids) {
+		detail = fmt.Sprintf("%s and %d more", detail, col.Total-len(ids))
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s %s is still referenced by %d entries", linkType, id, col.Total),
+		Detail:   fmt.Sprintf("Remove the links from the following entries or set prevent_delete_if_referenced to false: %s", detail),
+	}}
+}
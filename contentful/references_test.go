@@ -0,0 +1,38 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckIncomingLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/spaces/space-id/entries", r.URL.Path)
+
+		switch {
+		case r.URL.Query().Get("links_to_entry") == "author":
+			_, _ = fmt.Fprint(w, `{"total": 2, "items": [{"sys": {"id": "post-1"}}, {"sys": {"id": "post-2"}}]}`)
+		case r.URL.Query().Get("links_to_asset") == "logo":
+			_, _ = fmt.Fprint(w, `{"total": 0, "items": []}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	diags := checkIncomingLinks(client, "space-id", "Entry", "author")
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Entry author is still referenced by 2 entries", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "post-1, post-2")
+
+	diags = checkIncomingLinks(client, "space-id", "Asset", "logo")
+	assert.False(t, diags.HasError())
+}
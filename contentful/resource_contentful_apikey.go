@@ -3,12 +3,40 @@ package contentful
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/contentful-go"
 )
 
+// apiKey holds the environments and preview API key of an API key, which
+// contentful-go does not decode.
+type apiKey struct {
+	Sys           *apiKeySys `json:"sys,omitempty"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	AccessToken   string     `json:"accessToken,omitempty"`
+	Environments  []link     `json:"environments,omitempty"`
+	PreviewAPIKey *link      `json:"preview_api_key,omitempty"`
+}
+
+type apiKeySys struct {
+	ID      string `json:"id,omitempty"`
+	Version int    `json:"version,omitempty"`
+}
+
+func (k *apiKey) environmentIDs() []string {
+	ids := []string{}
+	for _, environment := range k.Environments {
+		ids = append(ids, environment.Sys.ID)
+	}
+
+	return ids
+}
+
 func resourceContentfulAPIKey() *schema.Resource {
 	return &schema.Resource{
 		Description: "A Contentful API Key represents a token that can be used to authenticate against the Contentful Content Delivery API and Content Preview API.",
@@ -17,6 +45,7 @@ func resourceContentfulAPIKey() *schema.Resource {
 		ReadContext:   resourceReadAPIKey,
 		UpdateContext: resourceUpdateAPIKey,
 		DeleteContext: resourceDeleteAPIKey,
+		CustomizeDiff: resourceAPIKeyCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,8 +55,16 @@ func resourceContentfulAPIKey() *schema.Resource {
 				Computed: true,
 			},
 			"access_token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token for the Content Delivery API.",
+			},
+			"preview_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token for the Content Preview API.",
 			},
 			"space_id": {
 				Type:     schema.TypeString,
@@ -41,72 +78,123 @@ func resourceContentfulAPIKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"environments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the environments the API key has access to. Contentful grants access to `master` when none are set.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value, e.g. a date. Changing it creates a new API key with the same name, description and environments, rotating its tokens. The previous API key stays valid, so the resources using its tokens can switch to the new ones during the apply, and is deleted by the next apply.",
+			},
+			"previous_api_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the API key that was replaced by the last rotation and is deleted by the next apply.",
+			},
 		},
 	}
 }
 
 func resourceCreateAPIKey(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
 
-	apiKey := &contentful.APIKey{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-	}
+	key := buildAPIKey(d)
 
-	err := client.APIKeys.Upsert(d.Get("space_id").(string), apiKey)
+	req, err := newRequest(client, http.MethodPost, fmt.Sprintf("/spaces/%s/api_keys", spaceID), nil, key)
 	if err != nil {
 		return parseError(err)
 	}
 
-	if err := setAPIKeyProperties(d, apiKey); err != nil {
+	if err = doRequest(req, key); err != nil {
 		return parseError(err)
 	}
 
-	d.SetId(apiKey.Sys.ID)
+	d.SetId(key.Sys.ID)
+
+	if err := setAPIKeyProperties(d, client, spaceID, key); err != nil {
+		return parseError(err)
+	}
 
 	return nil
 }
 
 func resourceUpdateAPIKey(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	apiKeyID := d.Id()
 
-	apiKey, err := client.APIKeys.Get(spaceID, apiKeyID)
+	previous, _ := d.GetChange("previous_api_key_id")
+	if previousID := previous.(string); previousID != "" {
+		err := deleteAPIKey(client, spaceID, previousID)
+		var notFoundError contentful.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			// Keep the previous state, so the next apply deletes the key again
+			d.Partial(true)
+			return parseError(err)
+		}
+
+		if err := d.Set("previous_api_key_id", ""); err != nil {
+			return parseError(err)
+		}
+	}
+
+	if d.HasChange("rotation_trigger") {
+		return rotateAPIKey(d, client, spaceID, apiKeyID)
+	}
+
+	if !d.HasChangesExcept("previous_api_key_id") {
+		return nil
+	}
+
+	existing, err := getAPIKey(client, spaceID, apiKeyID)
 	if err != nil {
 		return parseError(err)
 	}
 
-	apiKey.Name = d.Get("name").(string)
-	apiKey.Description = d.Get("description").(string)
+	key := buildAPIKey(d)
 
-	err = client.APIKeys.Upsert(spaceID, apiKey)
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKeyID), nil, key)
 	if err != nil {
 		return parseError(err)
 	}
 
-	if err := setAPIKeyProperties(d, apiKey); err != nil {
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(existing.Sys.Version))
+
+	if err = doRequest(req, key); err != nil {
+		return parseError(err)
+	}
+
+	if err := setAPIKeyProperties(d, client, spaceID, key); err != nil {
 		return parseError(err)
 	}
 
-	d.SetId(apiKey.Sys.ID)
+	d.SetId(key.Sys.ID)
 
 	return nil
 }
 
 func resourceReadAPIKey(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	apiKeyID := d.Id()
 
-	apiKey, err := client.APIKeys.Get(spaceID, apiKeyID)
+	key, err := getAPIKey(client, spaceID, apiKeyID)
 	var notFoundError contentful.NotFoundError
 	if errors.As(err, &notFoundError) {
 		d.SetId("")
 		return nil
 	}
 
-	err = setAPIKeyProperties(d, apiKey)
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = setAPIKeyProperties(d, client, spaceID, key)
 	if err != nil {
 		return parseError(err)
 	}
@@ -115,43 +203,167 @@ func resourceReadAPIKey(_ context.Context, d *schema.ResourceData, m interface{}
 }
 
 func resourceDeleteAPIKey(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
-	apiKeyID := d.Id()
 
-	apiKey, err := client.APIKeys.Get(spaceID, apiKeyID)
+	if previousID := d.Get("previous_api_key_id").(string); previousID != "" {
+		err := deleteAPIKey(client, spaceID, previousID)
+		var notFoundError contentful.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			return parseError(err)
+		}
+	}
+
+	return parseError(deleteAPIKey(client, spaceID, d.Id()))
+}
+
+// resourceAPIKeyCustomizeDiff plans new tokens when the API key is rotated and
+// the deletion of the API key replaced by the previous rotation.
+func resourceAPIKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("rotation_trigger") {
+		for _, key := range []string{"version", "access_token", "preview_token"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return d.SetNew("previous_api_key_id", d.Id())
+	}
+
+	if d.Get("previous_api_key_id").(string) != "" {
+		return d.SetNew("previous_api_key_id", "")
+	}
+
+	return nil
+}
+
+// rotateAPIKey creates a new API key from the configuration and records the
+// previous one, which is deleted by the next apply. Deleting it right away
+// would invalidate its tokens before the resources using them are updated.
+func rotateAPIKey(d *schema.ResourceData, client *contentful.Client, spaceID, previousID string) diag.Diagnostics {
+	key := buildAPIKey(d)
+
+	req, err := newRequest(client, http.MethodPost, fmt.Sprintf("/spaces/%s/api_keys", spaceID), nil, key)
 	if err != nil {
 		return parseError(err)
 	}
 
-	err = client.APIKeys.Delete(spaceID, apiKey)
-	if err != nil {
+	if err = doRequest(req, key); err != nil {
+		// Keep the previous state, so the next apply rotates the key again
+		d.Partial(true)
+		return parseError(err)
+	}
+
+	d.SetId(key.Sys.ID)
+
+	if err = d.Set("previous_api_key_id", previousID); err != nil {
+		return parseError(err)
+	}
+
+	if err = setAPIKeyProperties(d, client, spaceID, key); err != nil {
 		return parseError(err)
 	}
 
 	return nil
 }
 
-func setAPIKeyProperties(d *schema.ResourceData, apiKey *contentful.APIKey) error {
-	if err := d.Set("space_id", apiKey.Sys.Space.Sys.ID); err != nil {
+// buildAPIKey returns the API key as configured. The environments are only
+// sent when known, so Contentful applies its default otherwise.
+func buildAPIKey(d *schema.ResourceData) *apiKey {
+	key := &apiKey{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	for _, environment := range d.Get("environments").([]interface{}) {
+		key.Environments = append(key.Environments, newLink("Environment", environment.(string)))
+	}
+
+	return key
+}
+
+func deleteAPIKey(client *contentful.Client, spaceID, apiKeyID string) error {
+	apiKey, err := client.APIKeys.Get(spaceID, apiKeyID)
+	if err != nil {
 		return err
 	}
 
-	if err := d.Set("version", apiKey.Sys.Version); err != nil {
+	return client.APIKeys.Delete(spaceID, apiKey)
+}
+
+func getAPIKey(client *contentful.Client, spaceID, apiKeyID string) (*apiKey, error) {
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKeyID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key apiKey
+	if err := doRequest(req, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func setAPIKeyProperties(d *schema.ResourceData, client *contentful.Client, spaceID string, key *apiKey) error {
+	previewToken, err := getPreviewToken(client, spaceID, key)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return err
+	}
+
+	if err := d.Set("version", key.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", key.Name); err != nil {
 		return err
 	}
 
-	if err := d.Set("name", apiKey.Name); err != nil {
+	if err := d.Set("description", key.Description); err != nil {
 		return err
 	}
 
-	if err := d.Set("description", apiKey.Description); err != nil {
+	if err := d.Set("access_token", key.AccessToken); err != nil {
 		return err
 	}
 
-	if err := d.Set("access_token", apiKey.AccessToken); err != nil {
+	if err := d.Set("preview_token", previewToken); err != nil {
+		return err
+	}
+
+	if err := d.Set("environments", key.environmentIDs()); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// getPreviewToken returns the token of the preview API key that belongs to
+// the API key.
+func getPreviewToken(client *contentful.Client, spaceID string, key *apiKey) (string, error) {
+	if key.PreviewAPIKey == nil || key.PreviewAPIKey.Sys.ID == "" {
+		return "", nil
+	}
+
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/preview_api_keys/%s", spaceID, key.PreviewAPIKey.Sys.ID), nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var previewKey struct {
+		AccessToken string `json:"accessToken"`
+	}
+	if err := doRequest(req, &previewKey); err != nil {
+		return "", err
+	}
+
+	return previewKey.AccessToken, nil
+}
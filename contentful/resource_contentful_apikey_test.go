@@ -1,15 +1,120 @@
 package contentful
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestBuildAPIKey(t *testing.T) {
+	d := resourceContentfulAPIKey().TestResourceData()
+	assert.NoError(t, d.Set("name", "Website"))
+	assert.NoError(t, d.Set("environments", []string{"master", "staging"}))
+
+	key := buildAPIKey(d)
+	assert.Equal(t, "Website", key.Name)
+	assert.Equal(t, []link{newLink("Environment", "master"), newLink("Environment", "staging")}, key.Environments)
+
+	body, err := json.Marshal(buildAPIKey(resourceContentfulAPIKey().TestResourceData()))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "", "description": ""}`, string(body))
+}
+
+func TestResourceUpdateAPIKeyRotation(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /spaces/space-id/api_keys":
+			var body apiKey
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Website", body.Name)
+			assert.Equal(t, []link{newLink("Environment", "master")}, body.Environments)
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "new-key", "version": 1}, "name": "Website", "description": "", "accessToken": "new-token", "environments": [{"sys": {"id": "master", "type": "Link", "linkType": "Environment"}}], "preview_api_key": {"sys": {"id": "new-preview", "type": "Link", "linkType": "PreviewApiKey"}}}`)
+		case "GET /spaces/space-id/preview_api_keys/new-preview":
+			_, _ = fmt.Fprint(w, `{"accessToken": "new-preview-token"}`)
+		case "GET /spaces/space-id/api_keys/old-key":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "old-key", "version": 3}, "name": "Website"}`)
+		case "DELETE /spaces/space-id/api_keys/old-key":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	r := resourceContentfulAPIKey()
+	state := &terraform.InstanceState{
+		ID: "old-key",
+		Attributes: map[string]string{
+			"id":               "old-key",
+			"version":          "3",
+			"space_id":         "space-id",
+			"name":             "Website",
+			"description":      "",
+			"access_token":     "old-token",
+			"preview_token":    "old-preview-token",
+			"environments.#":   "1",
+			"environments.0":   "master",
+			"rotation_trigger": "2024-01-01",
+		},
+	}
+	config := func(trigger string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"space_id":         "space-id",
+			"name":             "Website",
+			"environments":     []interface{}{"master"},
+			"rotation_trigger": trigger,
+		})
+	}
+
+	// The rotation creates the new API key and keeps the previous one
+	diff, err := r.Diff(context.Background(), state, config("2024-07-01"), meta)
+	assert.NoError(t, err)
+	assert.Equal(t, "old-key", diff.Attributes["previous_api_key_id"].New)
+	assert.True(t, diff.Attributes["access_token"].NewComputed)
+
+	state, diags := r.Apply(context.Background(), state, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"POST /spaces/space-id/api_keys", "GET /spaces/space-id/preview_api_keys/new-preview"}, requests)
+	assert.Equal(t, "new-key", state.ID)
+	assert.Equal(t, "old-key", state.Attributes["previous_api_key_id"])
+	assert.Equal(t, "new-token", state.Attributes["access_token"])
+	assert.Equal(t, "new-preview-token", state.Attributes["preview_token"])
+
+	// The next apply deletes the previous API key without touching the new one
+	requests = nil
+	diff, err = r.Diff(context.Background(), state, config("2024-07-01"), meta)
+	assert.NoError(t, err)
+	assert.Equal(t, "", diff.Attributes["previous_api_key_id"].New)
+
+	state, diags = r.Apply(context.Background(), state, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"GET /spaces/space-id/api_keys/old-key", "DELETE /spaces/space-id/api_keys/old-key"}, requests)
+	assert.Equal(t, "new-key", state.ID)
+	assert.Equal(t, "", state.Attributes["previous_api_key_id"])
+
+	diff, err = r.Diff(context.Background(), state, config("2024-07-01"), meta)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+}
+
 func TestAccContentfulAPIKey_Basic(t *testing.T) {
 	var apiKey contentful.APIKey
 
@@ -30,6 +135,9 @@ func TestAccContentfulAPIKey_Basic(t *testing.T) {
 						"name":        name,
 						"description": description,
 					}),
+					resource.TestCheckResourceAttrSet("contentful_apikey.myapikey", "access_token"),
+					resource.TestCheckResourceAttrSet("contentful_apikey.myapikey", "preview_token"),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "environments.0", "master"),
 				),
 			},
 			{
@@ -47,6 +155,60 @@ func TestAccContentfulAPIKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccContentfulAPIKey_Rotation(t *testing.T) {
+	var previous, rotated contentful.APIKey
+
+	name := fmt.Sprintf("apikey-name-%s", acctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulAPIKeyRotationConfig(name, "2024-01-01"),
+				Check:  testAccCheckContentfulAPIKeyExists("contentful_apikey.myapikey", &previous),
+			},
+			{
+				Config: testAccContentfulAPIKeyRotationConfig(name, "2024-07-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulAPIKeyExists("contentful_apikey.myapikey", &rotated),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "name", name),
+					func(s *terraform.State) error {
+						if rotated.Sys.ID == previous.Sys.ID || rotated.AccessToken == previous.AccessToken {
+							return fmt.Errorf("api key %s was not rotated", previous.Sys.ID)
+						}
+
+						client := testAccProvider.Meta().(*providerMeta).client
+						if _, err := client.APIKeys.Get(spaceID, previous.Sys.ID); err != nil {
+							return fmt.Errorf("previous api key %s was deleted before the next apply: %w", previous.Sys.ID, err)
+						}
+
+						return resource.TestCheckResourceAttr("contentful_apikey.myapikey", "previous_api_key_id", previous.Sys.ID)(s)
+					},
+					resource.TestCheckResourceAttrPair("terraform_data.consumer", "output", "contentful_apikey.myapikey", "access_token"),
+				),
+				// The previous API key is deleted by the next apply
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccContentfulAPIKeyRotationConfig(name, "2024-07-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "previous_api_key_id", ""),
+					func(s *terraform.State) error {
+						client := testAccProvider.Meta().(*providerMeta).client
+						if _, err := client.APIKeys.Get(spaceID, previous.Sys.ID); err == nil {
+							return fmt.Errorf("previous api key %s still exists", previous.Sys.ID)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulAPIKeyExists(n string, apiKey *contentful.APIKey) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -64,7 +226,7 @@ func testAccCheckContentfulAPIKeyExists(n string, apiKey *contentful.APIKey) res
 			return fmt.Errorf("no api key ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		contentfulAPIKey, err := client.APIKeys.Get(spaceID, apiKeyID)
 		if err != nil {
@@ -110,7 +272,7 @@ func testAccContentfulAPIKeyDestroy(s *terraform.State) error {
 			return fmt.Errorf("no apikey ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		_, err := client.APIKeys.Get(spaceID, apiKeyID)
 		if _, ok := err.(contentful.NotFoundError); ok {
@@ -141,6 +303,32 @@ resource "contentful_apikey" "myapikey" {
 
   name = "%s-updated"
   description = "%s-updated"
+  environments = ["master"]
 }
 `, spaceID, name, description)
 }
+
+// testAccContentfulAPIKeyRotationConfig uses the access token in a dependent
+// resource. When the dependent is replaced, its destroy provisioner calls the
+// Content Delivery API with the previous token, which fails the apply if the
+// previous API key was deleted during the rotation.
+func testAccContentfulAPIKeyRotationConfig(name, trigger string) string {
+	return fmt.Sprintf(`
+resource "contentful_apikey" "myapikey" {
+  space_id = "%[1]s"
+
+  name = "%[2]s"
+  rotation_trigger = "%[3]s"
+}
+
+resource "terraform_data" "consumer" {
+  input = contentful_apikey.myapikey.access_token
+  triggers_replace = [contentful_apikey.myapikey.access_token]
+
+  provisioner "local-exec" {
+    when = destroy
+    command = "curl --fail --silent --output /dev/null https://cdn.contentful.com/spaces/%[1]s/environments/master/content_types?access_token=${self.output}"
+  }
+}
+`, spaceID, name, trigger)
+}
@@ -3,11 +3,15 @@ package contentful
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/contentful-go"
 )
 
@@ -18,9 +22,14 @@ func resourceContentfulAsset() *schema.Resource {
 		ReadContext:   resourceReadAsset,
 		UpdateContext: resourceUpdateAsset,
 		DeleteContext: resourceDeleteAsset,
+		CustomizeDiff: resourceAssetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"asset_id": {
 				Type:     schema.TypeString,
@@ -45,7 +54,7 @@ func resourceContentfulAsset() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"title": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"content": {
@@ -61,7 +70,7 @@ func resourceContentfulAsset() *schema.Resource {
 						},
 						"description": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"content": {
@@ -76,40 +85,55 @@ func resourceContentfulAsset() *schema.Resource {
 							},
 						},
 						"file": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The file of the asset, one block per locale. Each file is uploaded and processed independently.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"locale": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The locale of the file. Defaults to the locale of the asset.",
+									},
 									"url": {
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The URL of the processed file.",
 									},
 									"upload": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "A publicly reachable URL Contentful fetches the file from. Either `upload` or `source_path` must be set.",
+									},
+									"source_path": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Path to a local file that is uploaded through the Contentful Upload API. Changes to the file contents trigger a new upload.",
 									},
 									"details": {
-										Type:     schema.TypeSet,
-										Optional: true,
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The details of the processed file.",
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"size": {
-													Type:     schema.TypeInt,
-													Required: true,
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The size of the file in bytes.",
 												},
 												"image": {
-													Type:     schema.TypeSet,
-													Required: true,
+													Type:        schema.TypeList,
+													Computed:    true,
+													Description: "The dimensions of the file, only set for images.",
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"width": {
 																Type:     schema.TypeInt,
-																Required: true,
+																Computed: true,
 															},
 															"height": {
 																Type:     schema.TypeInt,
-																Required: true,
+																Computed: true,
 															},
 														},
 													},
@@ -135,6 +159,23 @@ func resourceContentfulAsset() *schema.Resource {
 					},
 				},
 			},
+			"mime_group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MIME type group of the file in the locale of the asset, e.g. `image` or `pdfdocument`.",
+			},
+			"source_hashes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SHA256 checksum of the uploaded `source_path` file, keyed by locale.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the tags applied to the asset.",
+			},
 			"published": {
 				Type:     schema.TypeBool,
 				Required: true,
@@ -143,193 +184,157 @@ func resourceContentfulAsset() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			"prevent_delete_if_referenced": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail to destroy the asset while other entries link to it. Does not apply to the `abandon` deletion policy.",
+			},
+			"deletion_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "delete",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"delete", "archive", "unpublish", "abandon"}, false)),
+				Description:      "What happens to the asset when the resource is destroyed: `delete` unpublishes and deletes it, `archive` unpublishes and archives it, `unpublish` only unpublishes it and `abandon` only removes it from the Terraform state.",
+			},
 		},
 	}
 }
 
-func resourceCreateAsset(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
-
-	fields := d.Get("fields").([]interface{})[0].(map[string]interface{})
+func resourceCreateAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
 
-	localizedTitle := map[string]string{}
-	rawTitle := fields["title"].([]interface{})
-	for i := 0; i < len(rawTitle); i++ {
-		field := rawTitle[i].(map[string]interface{})
-		localizedTitle[field["locale"].(string)] = field["content"].(string)
+	asset, process, sourceHashes, err := buildAsset(client, d, nil)
+	if err != nil {
+		return parseError(err)
 	}
 
-	localizedDescription := map[string]string{}
-	rawDescription := fields["description"].([]interface{})
-	for i := 0; i < len(rawDescription); i++ {
-		field := rawDescription[i].(map[string]interface{})
-		localizedDescription[field["locale"].(string)] = field["content"].(string)
+	if err := upsertAssetWithMetadata(client, spaceID, asset, newMetadata(d.Get("tags").(*schema.Set))); err != nil {
+		return parseError(err)
 	}
 
-	files := fields["file"].([]interface{})
+	d.SetId(asset.Sys.ID)
 
-	if len(files) == 0 {
-		return diag.Errorf("file block not defined in asset")
+	if err := processAssetFiles(ctx, client, spaceID, asset, process, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return parseError(err)
 	}
 
-	file := files[0].(map[string]interface{})
+	if err := d.Set("source_hashes", sourceHashes); err != nil {
+		return parseError(err)
+	}
 
-	asset := &contentful.Asset{
-		Sys: &contentful.Sys{
-			ID:      d.Get("asset_id").(string),
-			Version: 0,
-		},
-		Locale: d.Get("locale").(string),
-		Fields: &contentful.AssetFields{
-			Title:       localizedTitle,
-			Description: localizedDescription,
-			File: map[string]*contentful.File{
-				d.Get("locale").(string): {
-					FileName:    file["file_name"].(string),
-					ContentType: file["content_type"].(string),
-				},
-			},
-		},
+	if err := setAssetProperties(d, asset); err != nil {
+		return parseError(err)
 	}
 
-	if url, ok := file["url"].(string); ok && url != "" {
-		asset.Fields.File[d.Get("locale").(string)].URL = url
+	if err := setAssetState(d, m); err != nil {
+		return parseError(err)
 	}
 
-	if upload, ok := file["upload"].(string); ok && upload != "" {
-		asset.Fields.File[d.Get("locale").(string)].UploadURL = upload
+	return nil
+}
+
+func resourceUpdateAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	assetID := d.Id()
+
+	// The deletion settings are only used by Delete and already in the state
+	if !d.HasChangesExcept("deletion_policy", "prevent_delete_if_referenced") {
+		return nil
 	}
 
-	if details, ok := file["file_details"].(*contentful.FileDetails); ok {
-		asset.Fields.File[d.Get("locale").(string)].Details = details
+	existing, err := client.Assets.Get(spaceID, assetID)
+	if err != nil {
+		return parseError(err)
 	}
 
-	if uploadFrom, ok := file["upload_from"].(string); ok && uploadFrom != "" {
-		asset.Fields.File[d.Get("locale").(string)].UploadFrom = &contentful.UploadFrom{
-			Sys: &contentful.Sys{
-				ID: uploadFrom,
-			},
-		}
+	asset, process, sourceHashes, err := buildAsset(client, d, existing)
+	if err != nil {
+		return parseError(err)
 	}
 
-	if err := client.Assets.Upsert(d.Get("space_id").(string), asset); err != nil {
+	if err := upsertAssetWithMetadata(client, spaceID, asset, newMetadata(d.Get("tags").(*schema.Set))); err != nil {
 		return parseError(err)
 	}
 
-	if err := client.Assets.Process(d.Get("space_id").(string), asset); err != nil {
+	if err = processAssetFiles(ctx, client, spaceID, asset, process, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return parseError(err)
 	}
 
 	d.SetId(asset.Sys.ID)
 
-	if err := setAssetProperties(d, asset); err != nil {
+	if err := d.Set("source_hashes", sourceHashes); err != nil {
 		return parseError(err)
 	}
 
-	time.Sleep(1 * time.Second) // avoid race conditions with version mismatches
+	if err := setAssetProperties(d, asset); err != nil {
+		return parseError(err)
+	}
 
-	if err := setAssetState(d, m); err != nil {
+	if err = setAssetState(d, m); err != nil {
 		return parseError(err)
 	}
 
 	return nil
 }
 
-func resourceUpdateAsset(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
-	spaceID := d.Get("space_id").(string)
-	assetID := d.Id()
-
-	_, err := client.Assets.Get(spaceID, assetID)
-	if err != nil {
-		return parseError(err)
-	}
-
+// buildAsset assembles the asset from the configuration. It returns the asset
+// along with the locales of the files that have to be processed and the
+// checksums of the local sources. existing is nil when the asset is created.
+func buildAsset(client *contentful.Client, d *schema.ResourceData, existing *contentful.Asset) (*contentful.Asset, []string, map[string]interface{}, error) {
 	fields := d.Get("fields").([]interface{})[0].(map[string]interface{})
 
-	localizedTitle := map[string]string{}
-	rawTitle := fields["title"].([]interface{})
-	for i := 0; i < len(rawTitle); i++ {
-		field := rawTitle[i].(map[string]interface{})
-		localizedTitle[field["locale"].(string)] = field["content"].(string)
+	files := fields["file"].([]interface{})
+	if len(files) == 0 {
+		return nil, nil, nil, errors.New("file block not defined in asset")
 	}
 
-	localizedDescription := map[string]string{}
-	rawDescription := fields["description"].([]interface{})
-	for i := 0; i < len(rawDescription); i++ {
-		field := rawDescription[i].(map[string]interface{})
-		localizedDescription[field["locale"].(string)] = field["content"].(string)
+	localizedFiles, process, sourceHashes, err := buildAssetFiles(client, d, files, existing)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	files := fields["file"].([]interface{})
-
-	if len(files) == 0 {
-		return diag.Errorf("file block not defined in asset")
+	version := 0
+	if existing != nil {
+		version = d.Get("version").(int)
 	}
 
-	file := files[0].(map[string]interface{})
-
 	asset := &contentful.Asset{
 		Sys: &contentful.Sys{
 			ID:      d.Get("asset_id").(string),
-			Version: d.Get("version").(int),
+			Version: version,
 		},
 		Locale: d.Get("locale").(string),
 		Fields: &contentful.AssetFields{
-			Title:       localizedTitle,
-			Description: localizedDescription,
-			File: map[string]*contentful.File{
-				d.Get("locale").(string): {
-					FileName:    file["file_name"].(string),
-					ContentType: file["content_type"].(string),
-				},
-			},
+			Title:       buildLocalizedText(fields["title"].([]interface{})),
+			Description: buildLocalizedText(fields["description"].([]interface{})),
+			File:        localizedFiles,
 		},
 	}
 
-	if url, ok := file["url"].(string); ok && url != "" {
-		asset.Fields.File[d.Get("locale").(string)].URL = url
-	}
-
-	if upload, ok := file["upload"].(string); ok && upload != "" {
-		asset.Fields.File[d.Get("locale").(string)].UploadURL = upload
-	}
-
-	if details, ok := file["file_details"].(*contentful.FileDetails); ok {
-		asset.Fields.File[d.Get("locale").(string)].Details = details
-	}
-
-	if uploadFrom, ok := file["upload_from"].(string); ok && uploadFrom != "" {
-		asset.Fields.File[d.Get("locale").(string)].UploadFrom = &contentful.UploadFrom{
-			Sys: &contentful.Sys{
-				ID: uploadFrom,
-			},
-		}
-	}
-
-	if err := client.Assets.Upsert(d.Get("space_id").(string), asset); err != nil {
-		return parseError(err)
-	}
-
-	if err = client.Assets.Process(d.Get("space_id").(string), asset); err != nil {
-		return parseError(err)
-	}
-
-	d.SetId(asset.Sys.ID)
+	return asset, process, sourceHashes, nil
+}
 
-	if err := setAssetProperties(d, asset); err != nil {
-		return parseError(err)
+// buildLocalizedText maps the content of the title or description blocks by
+// locale. It returns nil when no blocks are set, so the field is not sent.
+func buildLocalizedText(rawText []interface{}) map[string]string {
+	if len(rawText) == 0 {
+		return nil
 	}
 
-	if err = setAssetState(d, m); err != nil {
-		return parseError(err)
+	localized := map[string]string{}
+	for _, rawField := range rawText {
+		field := rawField.(map[string]interface{})
+		localized[field["locale"].(string)] = field["content"].(string)
 	}
 
-	return nil
+	return localized
 }
 
 func setAssetState(d *schema.ResourceData, m interface{}) (err error) {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	assetID := d.Id()
 
@@ -360,18 +365,28 @@ func setAssetState(d *schema.ResourceData, m interface{}) (err error) {
 }
 
 func resourceReadAsset(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	assetID := d.Id()
 
-	asset, err := client.Assets.Get(spaceID, assetID)
+	var asset contentful.Asset
+	meta, err := getEntityWithMetadata(client, fmt.Sprintf("/spaces/%s/assets/%s", spaceID, assetID), &asset)
 	var notFoundError contentful.NotFoundError
 	if errors.As(err, &notFoundError) {
 		d.SetId("")
 		return nil
 	}
 
-	err = setAssetProperties(d, asset)
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = setAssetProperties(d, &asset)
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = d.Set("tags", meta.tagIDs())
 	if err != nil {
 		return parseError(err)
 	}
@@ -380,16 +395,51 @@ func resourceReadAsset(_ context.Context, d *schema.ResourceData, m interface{})
 }
 
 func resourceDeleteAsset(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	assetID := d.Id()
+	policy := d.Get("deletion_policy").(string)
+
+	if policy == "abandon" {
+		return nil
+	}
 
 	asset, err := client.Assets.Get(spaceID, assetID)
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
 	if err != nil {
 		return parseError(err)
 	}
 
-	err = client.Assets.Delete(spaceID, asset)
+	if d.Get("prevent_delete_if_referenced").(bool) {
+		if diags := checkIncomingLinks(client, spaceID, "Asset", assetID); diags.HasError() {
+			return diags
+		}
+	}
+
+	if asset.Sys.PublishedAt != "" {
+		if err = client.Assets.Unpublish(spaceID, asset); err != nil {
+			return parseError(err)
+		}
+
+		// Unpublishing increments the version of the asset
+		if asset, err = client.Assets.Get(spaceID, assetID); err != nil {
+			return parseError(err)
+		}
+	}
+
+	switch policy {
+	case "archive":
+		if asset.Sys.ArchivedAt == "" {
+			err = client.Assets.Archive(spaceID, asset)
+		}
+	case "delete":
+		err = client.Assets.Delete(spaceID, asset)
+	}
+
 	if err != nil {
 		return parseError(err)
 	}
@@ -406,5 +456,332 @@ func setAssetProperties(d *schema.ResourceData, asset *contentful.Asset) (err er
 		return err
 	}
 
+	if err = setAssetFileDetails(d, asset); err != nil {
+		return err
+	}
+
 	return err
 }
+
+// setAssetFileDetails fills the computed attributes of the file blocks from
+// the processed files of the asset.
+func setAssetFileDetails(d *schema.ResourceData, asset *contentful.Asset) error {
+	if asset.Fields == nil {
+		return nil
+	}
+
+	mimeGroup := ""
+	if file := asset.Fields.File[d.Get("locale").(string)]; file != nil {
+		mimeGroup = assetMimeGroup(file.ContentType)
+	}
+
+	if err := d.Set("mime_group", mimeGroup); err != nil {
+		return err
+	}
+
+	rawFields := d.Get("fields").([]interface{})
+	if len(rawFields) == 0 || rawFields[0] == nil {
+		return nil
+	}
+
+	fields := rawFields[0].(map[string]interface{})
+	for _, rawFile := range fields["file"].([]interface{}) {
+		file := rawFile.(map[string]interface{})
+		file["url"] = ""
+		file["details"] = []interface{}{}
+
+		processed := asset.Fields.File[assetFileLocale(file, d.Get("locale").(string))]
+		if processed == nil {
+			continue
+		}
+
+		file["url"] = processed.URL
+
+		if processed.Details != nil {
+			details := map[string]interface{}{
+				"size":  processed.Details.Size,
+				"image": []interface{}{},
+			}
+
+			if processed.Details.Image != nil {
+				details["image"] = []interface{}{map[string]interface{}{
+					"width":  processed.Details.Image.Width,
+					"height": processed.Details.Image.Height,
+				}}
+			}
+
+			file["details"] = []interface{}{details}
+		}
+	}
+
+	return d.Set("fields", rawFields)
+}
+
+// resourceAssetCustomizeDiff plans a new upload when the contents of the local
+// source_path file differ from the last uploaded version, and checks that every
+// file sets either upload or source_path.
+func resourceAssetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// The local files can not be hashed before the configuration is known, so a
+	// new upload is planned to not miss a changed file
+	if !d.NewValueKnown("fields") || !d.NewValueKnown("locale") {
+		return d.SetNewComputed("source_hashes")
+	}
+
+	hashes := map[string]interface{}{}
+	hashesKnown := true
+	for i, rawFields := range d.Get("fields").([]interface{}) {
+		fields, ok := rawFields.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for j, rawFile := range fields["file"].([]interface{}) {
+			file, ok := rawFile.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			prefix := fmt.Sprintf("fields.%d.file.%d.", i, j)
+			if !d.NewValueKnown(prefix+"upload") || !d.NewValueKnown(prefix+"source_path") || !d.NewValueKnown(prefix+"locale") {
+				hashesKnown = false
+				continue
+			}
+
+			sourcePath := file["source_path"].(string)
+			if (file["upload"].(string) == "") == (sourcePath == "") {
+				return fmt.Errorf("file %d of the asset must set exactly one of upload or source_path", j)
+			}
+
+			if sourcePath == "" {
+				continue
+			}
+
+			hash, err := fileHash(sourcePath)
+			if err != nil {
+				return err
+			}
+
+			hashes[assetFileLocale(file, d.Get("locale").(string))] = hash
+		}
+	}
+
+	if !hashesKnown {
+		return d.SetNewComputed("source_hashes")
+	}
+
+	current := d.Get("source_hashes").(map[string]interface{})
+	if len(current) == len(hashes) {
+		changed := false
+		for locale, hash := range hashes {
+			if current[locale] != hash {
+				changed = true
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return d.SetNew("source_hashes", hashes)
+}
+
+// setAssetFileSource points the file at its source: the local source_path is
+// uploaded through the Upload API, otherwise Contentful fetches the upload url.
+// It returns the checksum of the uploaded local file.
+func setAssetFileSource(client *contentful.Client, spaceID string, file map[string]interface{}, target *contentful.File) (string, error) {
+	if sourcePath, ok := file["source_path"].(string); ok && sourcePath != "" {
+		uploadID, hash, err := createUpload(client, uploadBaseURL(client), spaceID, sourcePath)
+		if err != nil {
+			return "", err
+		}
+
+		target.UploadURL = ""
+		target.UploadFrom = &contentful.UploadFrom{
+			Sys: &contentful.Sys{
+				ID:       uploadID,
+				Type:     "Link",
+				LinkType: "Upload",
+			},
+		}
+
+		return hash, nil
+	}
+
+	if upload, ok := file["upload"].(string); ok && upload != "" {
+		return "", nil
+	}
+
+	return "", errors.New("either upload or source_path must be set in the file block")
+}
+
+// assetFileLocale returns the locale of a file block, which defaults to the
+// locale of the asset.
+func assetFileLocale(file map[string]interface{}, defaultLocale string) string {
+	if locale, ok := file["locale"].(string); ok && locale != "" {
+		return locale
+	}
+
+	return defaultLocale
+}
+
+// buildAssetFiles assembles the localized files of the asset from the file
+// blocks and returns them along with the locales that have to be processed
+// and the checksums of the local sources. When existing is given, processed
+// files of which the local source did not change since the last upload are
+// kept as they are.
+func buildAssetFiles(client *contentful.Client, d *schema.ResourceData, rawFiles []interface{}, existing *contentful.Asset) (map[string]*contentful.File, []string, map[string]interface{}, error) {
+	spaceID := d.Get("space_id").(string)
+	oldHashes, _ := d.GetChange("source_hashes")
+
+	localizedFiles := map[string]*contentful.File{}
+	process := []string{}
+	sourceHashes := map[string]interface{}{}
+
+	for _, rawFile := range rawFiles {
+		file := rawFile.(map[string]interface{})
+		locale := assetFileLocale(file, d.Get("locale").(string))
+
+		if _, ok := localizedFiles[locale]; ok {
+			return nil, nil, nil, fmt.Errorf("more than one file block for locale %s", locale)
+		}
+
+		localizedFile := &contentful.File{
+			FileName:    file["file_name"].(string),
+			ContentType: file["content_type"].(string),
+		}
+
+		if upload, ok := file["upload"].(string); ok && upload != "" {
+			localizedFile.UploadURL = upload
+		}
+
+		if uploadFrom, ok := file["upload_from"].(string); ok && uploadFrom != "" {
+			localizedFile.UploadFrom = &contentful.UploadFrom{
+				Sys: &contentful.Sys{
+					ID: uploadFrom,
+				},
+			}
+		}
+
+		sourcePath := file["source_path"].(string)
+		if sourcePath != "" && existing != nil && existing.Fields != nil && existing.Fields.File[locale] != nil && existing.Fields.File[locale].URL != "" {
+			hash, err := fileHash(sourcePath)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			if oldHashes.(map[string]interface{})[locale] == hash {
+				existingFile := existing.Fields.File[locale]
+				existingFile.FileName = localizedFile.FileName
+				existingFile.ContentType = localizedFile.ContentType
+				localizedFiles[locale] = existingFile
+				sourceHashes[locale] = hash
+				continue
+			}
+		}
+
+		hash, err := setAssetFileSource(client, spaceID, file, localizedFile)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if hash != "" {
+			sourceHashes[locale] = hash
+		}
+
+		localizedFiles[locale] = localizedFile
+		process = append(process, locale)
+	}
+
+	sort.Strings(process)
+
+	return localizedFiles, process, sourceHashes, nil
+}
+
+// processAssetFiles processes the files of the given locales one at a time.
+// Processing increments the version of the asset, so each file has to be
+// processed before the next one can be requested.
+func processAssetFiles(ctx context.Context, client *contentful.Client, spaceID string, asset *contentful.Asset, locales []string, timeout time.Duration) error {
+	for _, locale := range locales {
+		asset.Locale = locale
+		if err := client.Assets.Process(spaceID, asset); err != nil {
+			return err
+		}
+
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			current, err := client.Assets.Get(spaceID, asset.Sys.ID)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if current.Fields == nil || current.Fields.File[locale] == nil || current.Fields.File[locale].URL == "" {
+				return retry.RetryableError(fmt.Errorf("file of locale %s is still being processed", locale))
+			}
+
+			asset.Sys = current.Sys
+			asset.Fields = current.Fields
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assetMimeGroups maps content types to the MIME type groups Contentful uses
+// to filter assets. Types that are not listed belong to the attachment group.
+var assetMimeGroups = map[string]string{
+	"text/plain":         "plaintext",
+	"application/msword": "richtext",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": "richtext",
+	"application/vnd.oasis.opendocument.text":                                 "richtext",
+	"application/rtf":               "richtext",
+	"text/rtf":                      "richtext",
+	"application/vnd.ms-powerpoint": "presentation",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": "presentation",
+	"application/vnd.oasis.opendocument.presentation":                           "presentation",
+	"application/vnd.apple.keynote":                                             "presentation",
+	"application/vnd.ms-excel":                                                  "spreadsheet",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         "spreadsheet",
+	"application/vnd.oasis.opendocument.spreadsheet":                            "spreadsheet",
+	"text/csv":                    "spreadsheet",
+	"application/pdf":             "pdfdocument",
+	"application/zip":             "archive",
+	"application/gzip":            "archive",
+	"application/x-gzip":          "archive",
+	"application/x-tar":           "archive",
+	"application/x-7z-compressed": "archive",
+	"application/vnd.rar":         "archive",
+	"application/json":            "code",
+	"application/javascript":      "code",
+	"text/javascript":             "code",
+	"text/css":                    "code",
+	"text/html":                   "markup",
+	"text/xml":                    "markup",
+	"application/xml":             "markup",
+	"application/xhtml+xml":       "markup",
+	"text/markdown":               "markup",
+}
+
+// assetMimeGroup returns the Contentful MIME type group of the content type
+func assetMimeGroup(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	for _, group := range []string{"image", "audio", "video"} {
+		if strings.HasPrefix(mediaType, group+"/") {
+			return group
+		}
+	}
+
+	if group, ok := assetMimeGroups[mediaType]; ok {
+		return group
+	}
+
+	return "attachment"
+}
@@ -1,14 +1,82 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestAssetMimeGroup(t *testing.T) {
+	assert.Equal(t, "image", assetMimeGroup("image/svg+xml"))
+	assert.Equal(t, "video", assetMimeGroup("video/mp4"))
+	assert.Equal(t, "pdfdocument", assetMimeGroup("application/pdf"))
+	assert.Equal(t, "plaintext", assetMimeGroup("text/plain; charset=utf-8"))
+	assert.Equal(t, "attachment", assetMimeGroup("application/octet-stream"))
+	assert.Equal(t, "", assetMimeGroup(""))
+}
+
+func TestBuildLocalizedText(t *testing.T) {
+	assert.Nil(t, buildLocalizedText([]interface{}{}))
+	assert.Equal(t, map[string]string{"en-US": "Title", "de": "Titel"}, buildLocalizedText([]interface{}{
+		map[string]interface{}{"locale": "en-US", "content": "Title"},
+		map[string]interface{}{"locale": "de", "content": "Titel"},
+	}))
+}
+
+// unknownValue marks a value as unknown in terraform.NewResourceConfigRaw
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func testAssetDiffConfig(file map[string]interface{}) *terraform.ResourceConfig {
+	file["file_name"] = "image.png"
+	file["content_type"] = "image/png"
+
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		"asset_id":  "asset-id",
+		"locale":    "en-US",
+		"space_id":  "space-id",
+		"published": false,
+		"archived":  false,
+		"fields": []interface{}{
+			map[string]interface{}{"file": []interface{}{file}},
+		},
+	})
+}
+
+func TestResourceAssetCustomizeDiff(t *testing.T) {
+	r := resourceContentfulAsset()
+
+	_, err := r.Diff(context.Background(), nil, testAssetDiffConfig(map[string]interface{}{}), nil)
+	assert.ErrorContains(t, err, "exactly one of upload or source_path")
+
+	_, err = r.Diff(context.Background(), nil, testAssetDiffConfig(map[string]interface{}{
+		"upload":      "https://example.com/image.png",
+		"source_path": "image.png",
+	}), nil)
+	assert.ErrorContains(t, err, "exactly one of upload or source_path")
+
+	diff, err := r.Diff(context.Background(), nil, testAssetDiffConfig(map[string]interface{}{
+		"source_path": unknownValue,
+	}), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["source_hashes.%"].NewComputed)
+
+	sourcePath := filepath.Join(t.TempDir(), "image.png")
+	assert.NoError(t, os.WriteFile(sourcePath, []byte("image"), 0o600))
+
+	diff, err = r.Diff(context.Background(), nil, testAssetDiffConfig(map[string]interface{}{
+		"source_path": sourcePath,
+	}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d", diff.Attributes["source_hashes.en-US"].New)
+}
+
 func TestAccContentfulAsset_Basic(t *testing.T) {
 	var asset contentful.Asset
 
@@ -39,6 +107,71 @@ func TestAccContentfulAsset_Basic(t *testing.T) {
 	})
 }
 
+func TestAccContentfulAsset_SourcePath(t *testing.T) {
+	var asset contentful.Asset
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulAssetSourcePathConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulAssetExists("contentful_asset.myasset", &asset),
+					testAccCheckContentfulAssetAttributes(&asset, map[string]interface{}{
+						"space_id": spaceID,
+					}),
+					resource.TestCheckResourceAttrSet("contentful_asset.myasset", "source_hashes.en-US"),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "mime_group", "image"),
+					resource.TestCheckResourceAttrSet("contentful_asset.myasset", "fields.0.file.0.url"),
+					resource.TestCheckResourceAttrSet("contentful_asset.myasset", "fields.0.file.0.details.0.size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContentfulAsset_MultipleLocales(t *testing.T) {
+	var asset contentful.Asset
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulAssetMultipleLocalesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulAssetExists("contentful_asset.myasset", &asset),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "fields.0.file.#", "2"),
+					resource.TestCheckResourceAttrSet("contentful_asset.myasset", "source_hashes.nl"),
+					resource.TestCheckNoResourceAttr("contentful_asset.myasset", "source_hashes.en-US"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContentfulAsset_DeletionPolicy(t *testing.T) {
+	var asset contentful.Asset
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulAssetArchived,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulAssetDeletionPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulAssetExists("contentful_asset.myasset", &asset),
+					resource.TestCheckResourceAttr("contentful_asset.myasset", "deletion_policy", "archive"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulAssetExists(n string, asset *contentful.Asset) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -51,7 +184,7 @@ func testAccCheckContentfulAssetExists(n string, asset *contentful.Asset) resour
 			return fmt.Errorf("no space_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		contentfulAsset, err := client.Assets.Get(spaceID, rs.Primary.ID)
 		if err != nil {
@@ -94,7 +227,7 @@ func testAccContentfulAssetDestroy(s *terraform.State) error {
 		}
 
 		// sdk client
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		asset, _ := client.Assets.Get(spaceID, rs.Primary.ID)
 		if asset == nil {
@@ -107,6 +240,33 @@ func testAccContentfulAssetDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccContentfulAssetArchived checks that the asset was archived instead of
+// deleted and removes it afterwards.
+func testAccContentfulAssetArchived(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_asset" {
+			continue
+		}
+
+		asset, err := client.Assets.Get(rs.Primary.Attributes["space_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("asset %s was deleted: %w", rs.Primary.ID, err)
+		}
+
+		if asset.Sys.ArchivedAt == "" {
+			return fmt.Errorf("asset %s is not archived", rs.Primary.ID)
+		}
+
+		if err = client.Assets.Delete(rs.Primary.Attributes["space_id"], asset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 var testAccContentfulAssetConfig = `
 resource "contentful_asset" "myasset" {
   asset_id = "test_asset"
@@ -156,3 +316,88 @@ resource "contentful_asset" "myasset" {
   archived = false
 }
 `
+
+var testAccContentfulAssetSourcePathConfig = `
+resource "contentful_asset" "myasset" {
+  asset_id = "test_asset_source_path"
+  locale = "en-US"
+  space_id = "` + spaceID + `"
+  fields {
+    title {
+      locale = "en-US"
+      content = "Asset title"
+    }
+    file {
+      source_path = "testdata/example.svg"
+      file_name = "example.svg"
+      content_type = "image/svg+xml"
+    }
+  }
+  published = false
+  archived = false
+}
+`
+
+var testAccContentfulAssetMultipleLocalesConfig = `
+resource "contentful_locale" "mylocale" {
+  space_id = "` + spaceID + `"
+
+  name = "Dutch"
+  code = "nl"
+  fallback_code = "en-US"
+  optional = true
+  cda = true
+  cma = true
+}
+
+resource "contentful_asset" "myasset" {
+  asset_id = "test_asset_locales"
+  locale = "en-US"
+  space_id = "` + spaceID + `"
+  fields {
+    title {
+      locale = "en-US"
+      content = "Asset title"
+    }
+    description {
+      locale = "en-US"
+      content = "Asset description"
+    }
+    file {
+      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+      file_name = "example.svg"
+      content_type = "image/svg+xml"
+    }
+    file {
+      locale = contentful_locale.mylocale.code
+      source_path = "testdata/example.svg"
+      file_name = "voorbeeld.svg"
+      content_type = "image/svg+xml"
+    }
+  }
+  published = false
+  archived = false
+}
+`
+
+var testAccContentfulAssetDeletionPolicyConfig = `
+resource "contentful_asset" "myasset" {
+  asset_id = "test_asset_deletion_policy"
+  locale = "en-US"
+  space_id = "` + spaceID + `"
+  fields {
+    title {
+      locale = "en-US"
+      content = "Asset title"
+    }
+    file {
+      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+      file_name = "example.svg"
+      content_type = "image/svg+xml"
+    }
+  }
+  deletion_policy = "archive"
+  published = true
+  archived = false
+}
+`
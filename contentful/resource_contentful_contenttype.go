@@ -117,7 +117,7 @@ func resourceContentfulContentType() *schema.Resource {
 }
 
 func resourceContentTypeCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 
 	ct := &contentful.ContentType{
@@ -182,7 +182,7 @@ func resourceContentTypeCreate(_ context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceContentTypeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 
 	_, err := client.ContentTypes.Get(spaceID, d.Id())
@@ -197,7 +197,7 @@ func resourceContentTypeUpdate(_ context.Context, d *schema.ResourceData, m inte
 	var existingFields []*contentful.Field
 	var deletedFields []*contentful.Field
 
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 
 	ct, err := client.ContentTypes.Get(spaceID, d.Id())
@@ -255,7 +255,7 @@ func resourceContentTypeUpdate(_ context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceContentTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-    client := m.(*contentful.Client)
+    client := m.(*providerMeta).client
     spaceID := d.Get("space_id").(string)
 
     // Fetch the content type
@@ -51,7 +51,7 @@ func testAccCheckContentfulContentTypeExists(n string, contentType *contentful.C
 			return fmt.Errorf("no space_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		ct, err := client.ContentTypes.Get(spaceID, rs.Primary.ID)
 		if err != nil {
@@ -75,7 +75,7 @@ func testAccCheckContentfulContentTypeDestroy(s *terraform.State) (err error) {
 			return fmt.Errorf("no space_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		_, err := client.ContentTypes.Get(spaceID, rs.Primary.ID)
 		if _, ok := err.(contentful.NotFoundError); ok {
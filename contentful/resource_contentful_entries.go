@@ -0,0 +1,533 @@
+package contentful
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/contentful-go"
+	"gopkg.in/yaml.v3"
+)
+
+// bulkEntry is a single entry in the document of a contentful_entries resource
+type bulkEntry struct {
+	ContentType string                 `json:"content_type" yaml:"content_type"`
+	Published   bool                   `json:"published" yaml:"published"`
+	Fields      map[string]interface{} `json:"fields" yaml:"fields"`
+}
+
+func resourceContentfulEntries() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a set of Contentful Entries loaded from a JSON or YAML document, keyed by entry ID.",
+
+		CreateContext: resourceCreateEntries,
+		ReadContext:   resourceReadEntries,
+		UpdateContext: resourceUpdateEntries,
+		DeleteContext: resourceDeleteEntries,
+		CustomizeDiff: resourceEntriesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment of the entries. Defaults to the environment configured in the provider.",
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_file", "content"},
+				Description:  "Path to a JSON or YAML document with the entries, keyed by entry ID.",
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"source_file", "content"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				Description:      "Inline JSON document with the entries, keyed by entry ID.",
+			},
+			"max_concurrency": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 20)),
+				Description:      "The maximum number of entries that are written to Contentful in parallel.",
+			},
+			"entries": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A checksum of the content type, fields and publish state of every managed entry, keyed by entry ID.",
+			},
+		},
+	}
+}
+
+func resourceCreateEntries(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	if err := d.Set("environment", getEnvironment(d, client)); err != nil {
+		return parseError(err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	diags := applyEntries(d, client, map[string]interface{}{})
+	if !diags.HasError() {
+		return diags
+	}
+
+	if len(d.Get("entries").(map[string]interface{})) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	// An error would taint the resource, and replacing it deletes the entries
+	// that were written. The failures are reported as warnings instead, so the
+	// next apply only writes the failed entries.
+	for i := range diags {
+		if diags[i].Severity == diag.Error {
+			diags[i].Severity = diag.Warning
+		}
+	}
+
+	return diags
+}
+
+func resourceUpdateEntries(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	old, _ := d.GetChange("entries")
+
+	return applyEntries(d, client, old.(map[string]interface{}))
+}
+
+func resourceReadEntries(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	var ids []string
+	for entryID := range d.Get("entries").(map[string]interface{}) {
+		ids = append(ids, entryID)
+	}
+
+	var mu sync.Mutex
+	checksums := map[string]interface{}{}
+	errs := forEachConcurrently(ids, d.Get("max_concurrency").(int), func(entryID string) error {
+		entry, err := getEnvironmentEntry(client, spaceID, environment, entryID)
+		var notFoundError contentful.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		checksum, err := remoteEntryChecksum(entry)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		checksums[entryID] = checksum
+		mu.Unlock()
+
+		return nil
+	})
+
+	if diags := entryDiagnostics(errs); diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("entries", checksums); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceDeleteEntries(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	var ids []string
+	for entryID := range d.Get("entries").(map[string]interface{}) {
+		ids = append(ids, entryID)
+	}
+
+	errs := forEachConcurrently(ids, d.Get("max_concurrency").(int), func(entryID string) error {
+		return deleteEnvironmentEntry(client, spaceID, environment, entryID)
+	})
+
+	return entryDiagnostics(errs)
+}
+
+// resourceEntriesCustomizeDiff compares the checksums of the document with the
+// checksums of the entries in the space, so changes on either side show up in
+// the plan.
+func resourceEntriesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source_file") || !d.NewValueKnown("content") {
+		return d.SetNewComputed("entries")
+	}
+
+	document, err := loadEntriesDocument(d.Get("source_file").(string), d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	checksums := map[string]interface{}{}
+	for entryID, entry := range document {
+		checksum, err := entryChecksum(entry)
+		if err != nil {
+			return fmt.Errorf("entry %s: %w", entryID, err)
+		}
+
+		checksums[entryID] = checksum
+	}
+
+	current := d.Get("entries").(map[string]interface{})
+	if len(current) == len(checksums) {
+		changed := false
+		for entryID, checksum := range checksums {
+			if current[entryID] != checksum {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return d.SetNew("entries", checksums)
+}
+
+// applyEntries writes all entries of the document whose checksum differs from
+// the previous state and removes the entries that are no longer part of it.
+// Only the checksums of the entries that were written successfully are
+// stored, so the next plan shows the failed entries as changes again.
+func applyEntries(d *schema.ResourceData, client *contentful.Client, previous map[string]interface{}) diag.Diagnostics {
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+	limit := d.Get("max_concurrency").(int)
+
+	document, err := loadEntriesDocument(d.Get("source_file").(string), d.Get("content").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var mu sync.Mutex
+	checksums := map[string]interface{}{}
+	for entryID, checksum := range previous {
+		checksums[entryID] = checksum
+	}
+
+	var changed []string
+	for entryID, entry := range document {
+		checksum, err := entryChecksum(entry)
+		if err != nil {
+			return diag.Errorf("entry %s: %s", entryID, err)
+		}
+
+		if previous[entryID] != checksum {
+			changed = append(changed, entryID)
+		}
+	}
+
+	var removed []string
+	for entryID := range previous {
+		if _, ok := document[entryID]; !ok {
+			removed = append(removed, entryID)
+		}
+	}
+
+	errs := forEachConcurrently(changed, limit, func(entryID string) error {
+		if err := upsertEnvironmentEntry(client, spaceID, environment, entryID, document[entryID]); err != nil {
+			return err
+		}
+
+		checksum, _ := entryChecksum(document[entryID])
+
+		mu.Lock()
+		checksums[entryID] = checksum
+		mu.Unlock()
+
+		return nil
+	})
+
+	removeErrs := forEachConcurrently(removed, limit, func(entryID string) error {
+		if err := deleteEnvironmentEntry(client, spaceID, environment, entryID); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		delete(checksums, entryID)
+		mu.Unlock()
+
+		return nil
+	})
+
+	for entryID, err := range removeErrs {
+		errs[entryID] = err
+	}
+
+	if err := d.Set("entries", checksums); err != nil {
+		return parseError(err)
+	}
+
+	return entryDiagnostics(errs)
+}
+
+func loadEntriesDocument(sourceFile, content string) (map[string]*bulkEntry, error) {
+	document := map[string]*bulkEntry{}
+
+	if sourceFile == "" {
+		if err := json.Unmarshal([]byte(content), &document); err != nil {
+			return nil, fmt.Errorf("unable to parse content: %w", err)
+		}
+
+		return document, nil
+	}
+
+	data, err := os.ReadFile(sourceFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(sourceFile)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &document)
+	default:
+		err = json.Unmarshal(data, &document)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", sourceFile, err)
+	}
+
+	return document, nil
+}
+
+// entryChecksum returns a checksum of the entry that does not depend on the
+// notation of the document it was loaded from.
+func entryChecksum(entry *bulkEntry) (string, error) {
+	if entry == nil {
+		return "", fmt.Errorf("entry is empty")
+	}
+
+	if entry.ContentType == "" {
+		return "", fmt.Errorf("content_type is required")
+	}
+
+	fields, err := json.Marshal(entry.Fields)
+	if err != nil {
+		return "", err
+	}
+
+	// Round trip the fields through JSON so YAML and JSON numbers are equal
+	var normalized interface{}
+	if err := json.Unmarshal(fields, &normalized); err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal([]interface{}{entry.ContentType, entry.Published, normalized})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func remoteEntryChecksum(entry *contentful.Entry) (string, error) {
+	contentType := ""
+	if entry.Sys.ContentType != nil && entry.Sys.ContentType.Sys != nil {
+		contentType = entry.Sys.ContentType.Sys.ID
+	}
+
+	fields := entry.Fields
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+
+	return entryChecksum(&bulkEntry{
+		ContentType: contentType,
+		Published:   entry.Sys.PublishedAt != "",
+		Fields:      fields,
+	})
+}
+
+func getEnvironmentEntry(client *contentful.Client, spaceID, environment, entryID string) (*contentful.Entry, error) {
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, environment, entryID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry contentful.Entry
+	if err := doRequest(req, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func upsertEnvironmentEntry(client *contentful.Client, spaceID, environment, entryID string, document *bulkEntry) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, environment, entryID)
+
+	version := 0
+	published := false
+	entry, err := getEnvironmentEntry(client, spaceID, environment, entryID)
+	var notFoundError contentful.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		return err
+	}
+
+	if entry != nil {
+		if entry.Sys.ContentType != nil && entry.Sys.ContentType.Sys != nil && entry.Sys.ContentType.Sys.ID != document.ContentType {
+			return fmt.Errorf("content type of existing entry is %s, it can not be changed to %s", entry.Sys.ContentType.Sys.ID, document.ContentType)
+		}
+
+		version = entry.Sys.Version
+		published = entry.Sys.PublishedAt != ""
+	}
+
+	req, err := newRequest(client, http.MethodPut, path, nil, map[string]interface{}{"fields": document.Fields})
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Content-Type", document.ContentType)
+	if version > 0 {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(version))
+	}
+
+	entry = &contentful.Entry{}
+	if err = doRequest(req, entry); err != nil {
+		return err
+	}
+
+	if document.Published {
+		req, err = newRequest(client, http.MethodPut, path+"/published", nil, nil)
+	} else if published {
+		req, err = newRequest(client, http.MethodDelete, path+"/published", nil, nil)
+	} else {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+
+	return doRequest(req, nil)
+}
+
+func deleteEnvironmentEntry(client *contentful.Client, spaceID, environment, entryID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, environment, entryID)
+
+	entry, err := getEnvironmentEntry(client, spaceID, environment, entryID)
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if entry.Sys.PublishedAt != "" {
+		req, err := newRequest(client, http.MethodDelete, path+"/published", nil, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+
+		if err = doRequest(req, entry); err != nil {
+			return err
+		}
+	}
+
+	req, err := newRequest(client, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+
+	return doRequest(req, nil)
+}
+
+// forEachConcurrently calls fn for every key with at most limit calls running
+// at the same time and returns the errors by key.
+func forEachConcurrently(keys []string, limit int, fn func(key string) error) map[string]error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := map[string]error{}
+	semaphore := make(chan struct{}, limit)
+
+	for _, key := range keys {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := fn(key); err != nil {
+				mu.Lock()
+				errs[key] = err
+				mu.Unlock()
+			}
+		}(key)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// entryDiagnostics converts the errors per entry into diagnostics that name
+// the entry they belong to.
+func entryDiagnostics(errs map[string]error) diag.Diagnostics {
+	var ids []string
+	for entryID := range errs {
+		ids = append(ids, entryID)
+	}
+	sort.Strings(ids)
+
+	var diags diag.Diagnostics
+	for _, entryID := range ids {
+		for _, d := range parseError(errs[entryID]) {
+			d.Summary = fmt.Sprintf("entry %s: %s", entryID, d.Summary)
+			diags = append(diags, d)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,338 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadEntriesDocument_JSONAndYAML(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonFile := filepath.Join(dir, "entries.json")
+	assert.NoError(t, os.WriteFile(jsonFile, []byte(`{
+  "home": {
+    "content_type": "page",
+    "published": true,
+    "fields": {"title": {"en-US": "Home"}, "order": {"en-US": 1}}
+  }
+}`), 0o600))
+
+	yamlFile := filepath.Join(dir, "entries.yaml")
+	assert.NoError(t, os.WriteFile(yamlFile, []byte(`
+home:
+  content_type: page
+  published: true
+  fields:
+    title:
+      en-US: Home
+    order:
+      en-US: 1
+`), 0o600))
+
+	fromJSON, err := loadEntriesDocument(jsonFile, "")
+	assert.NoError(t, err)
+
+	fromYAML, err := loadEntriesDocument(yamlFile, "")
+	assert.NoError(t, err)
+
+	jsonChecksum, err := entryChecksum(fromJSON["home"])
+	assert.NoError(t, err)
+
+	yamlChecksum, err := entryChecksum(fromYAML["home"])
+	assert.NoError(t, err)
+
+	assert.Equal(t, jsonChecksum, yamlChecksum)
+}
+
+func TestLoadEntriesDocument_Inline(t *testing.T) {
+	document, err := loadEntriesDocument("", `{"home": {"content_type": "page", "fields": {}}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "page", document["home"].ContentType)
+	assert.False(t, document["home"].Published)
+
+	_, err = loadEntriesDocument("", `not json`)
+	assert.Error(t, err)
+}
+
+func TestEntryChecksum_RequiresContentType(t *testing.T) {
+	_, err := entryChecksum(&bulkEntry{})
+	assert.Error(t, err)
+
+	_, err = entryChecksum(nil)
+	assert.Error(t, err)
+}
+
+func TestRemoteEntryChecksum(t *testing.T) {
+	local, err := entryChecksum(&bulkEntry{
+		ContentType: "page",
+		Published:   true,
+		Fields:      map[string]interface{}{"title": map[string]interface{}{"en-US": "Home"}},
+	})
+	assert.NoError(t, err)
+
+	remote, err := remoteEntryChecksum(&contentful.Entry{
+		Sys: &contentful.Sys{
+			PublishedAt: "2024-01-01T00:00:00Z",
+			ContentType: &contentful.ContentType{Sys: &contentful.Sys{ID: "page"}},
+		},
+		Fields: map[string]interface{}{"title": map[string]interface{}{"en-US": "Home"}},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, local, remote)
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	var running, maxRunning int32
+
+	errs := forEachConcurrently([]string{"a", "b", "c", "d", "e"}, 2, func(key string) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			seen := atomic.LoadInt32(&maxRunning)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+				break
+			}
+		}
+
+		if key == "c" {
+			return fmt.Errorf("failed")
+		}
+
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs["c"], "failed")
+}
+
+func TestEntryDiagnostics(t *testing.T) {
+	diags := entryDiagnostics(map[string]error{
+		"b": fmt.Errorf("second"),
+		"a": contentful.ErrorResponse{Message: "first"},
+	})
+
+	assert.True(t, diags.HasError())
+	assert.Len(t, diags, 2)
+	assert.Equal(t, "entry a: first", diags[0].Summary)
+	assert.Equal(t, "entry b: second", diags[1].Summary)
+}
+
+func TestResourceCreateEntriesPartialFailure(t *testing.T) {
+	failAbout := true
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"sys": {"type": "Error", "id": "NotFound"}, "message": "The resource could not be found."}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/spaces/space-id/environments/master/entries/about" && failAbout:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"sys": {"type": "Error", "id": "ValidationFailed"}, "message": "Validation error"}`)
+		case r.Method == http.MethodPut:
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "entry-id", "version": 1}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	r := resourceContentfulEntries()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"space_id":        "space-id",
+		"environment":     "master",
+		"max_concurrency": 1,
+		"content":         `{"home": {"content_type": "page", "fields": {"title": {"en-US": "Home"}}}, "about": {"content_type": "page", "fields": {"title": {"en-US": "About"}}}}`,
+	})
+
+	// The failed entry is reported as a warning, so the resource is not tainted
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+	assert.NoError(t, err)
+
+	state, diags := r.Apply(context.Background(), &terraform.InstanceState{}, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "entry about: Validation error", diags[0].Summary)
+	assert.NotEmpty(t, state.ID)
+	assert.Equal(t, "1", state.Attributes["entries.%"])
+	assert.NotEmpty(t, state.Attributes["entries.home"])
+
+	// The next apply only writes the failed entry
+	failAbout = false
+	requests = nil
+
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	assert.NoError(t, err)
+
+	state, diags = r.Apply(context.Background(), state, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"GET /spaces/space-id/environments/master/entries/about", "PUT /spaces/space-id/environments/master/entries/about"}, requests)
+	assert.Equal(t, "2", state.Attributes["entries.%"])
+}
+
+func TestResourceCreateEntriesFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"sys": {"type": "Error", "id": "AccessTokenInvalid"}, "message": "The access token you sent could not be found or is invalid."}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	r := resourceContentfulEntries()
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"space_id":    "space-id",
+		"environment": "master",
+		"content":     `{"home": {"content_type": "page", "fields": {}}}`,
+	}), meta)
+	assert.NoError(t, err)
+
+	// Nothing was written, so no resource is stored
+	state, diags := r.Apply(context.Background(), &terraform.InstanceState{}, diff, meta)
+	assert.True(t, diags.HasError())
+	assert.Nil(t, state)
+}
+
+func TestAccContentfulEntries_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulEntriesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEntriesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "entries.%", "2"),
+					testAccCheckContentfulEntriesExist("contentful_entries.myentries", []string{"bulk-entry-1", "bulk-entry-2"}),
+				),
+			},
+			{
+				Config: testAccContentfulEntriesUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entries.myentries", "entries.%", "1"),
+					testAccCheckContentfulEntriesExist("contentful_entries.myentries", []string{"bulk-entry-1"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulEntriesExist(n string, ids []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		for _, entryID := range ids {
+			if _, err := getEnvironmentEntry(client, spaceID, rs.Primary.Attributes["environment"], entryID); err != nil {
+				return fmt.Errorf("entry %s: %w", entryID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulEntriesDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_entries" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		for _, entryID := range []string{"bulk-entry-1", "bulk-entry-2"} {
+			_, err := getEnvironmentEntry(client, spaceID, rs.Primary.Attributes["environment"], entryID)
+			var notFoundError contentful.NotFoundError
+			if !errors.As(err, &notFoundError) {
+				return fmt.Errorf("entry still exists with id: %s", entryID)
+			}
+		}
+	}
+
+	return nil
+}
+
+var testAccContentfulEntriesContentType = `
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "` + spaceID + `"
+  name = "tf_test_entries"
+  description = "Terraform Acc Test Content Type"
+  display_field = "field1"
+  field {
+    disabled  = false
+    id        = "field1"
+    localized = false
+    name      = "Field 1"
+    omitted   = false
+    required  = true
+    type      = "Text"
+  }
+}
+`
+
+var testAccContentfulEntriesConfig = testAccContentfulEntriesContentType + `
+resource "contentful_entries" "myentries" {
+  space_id = "` + spaceID + `"
+  content = jsonencode({
+    "bulk-entry-1" = {
+      content_type = contentful_contenttype.mycontenttype.id
+      published    = true
+      fields       = { field1 = { "en-US" = "Hello" } }
+    }
+    "bulk-entry-2" = {
+      content_type = contentful_contenttype.mycontenttype.id
+      published    = false
+      fields       = { field1 = { "en-US" = "World" } }
+    }
+  })
+}
+`
+
+var testAccContentfulEntriesUpdateConfig = testAccContentfulEntriesContentType + `
+resource "contentful_entries" "myentries" {
+  space_id = "` + spaceID + `"
+  content = jsonencode({
+    "bulk-entry-1" = {
+      content_type = contentful_contenttype.mycontenttype.id
+      published    = false
+      fields       = { field1 = { "en-US" = "Hello again" } }
+    }
+  })
+}
+`
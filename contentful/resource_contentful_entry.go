@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/contentful-go"
 )
 
@@ -64,6 +66,12 @@ func resourceContentfulEntry() *schema.Resource {
 					},
 				},
 			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the tags applied to the entry.",
+			},
 			"published": {
 				Type:     schema.TypeBool,
 				Required: true,
@@ -72,12 +80,25 @@ func resourceContentfulEntry() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			"prevent_delete_if_referenced": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail to destroy the entry while other entries link to it. Does not apply to the `abandon` deletion policy.",
+			},
+			"deletion_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "delete",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"delete", "archive", "unpublish", "abandon"}, false)),
+				Description:      "What happens to the entry when the resource is destroyed: `delete` unpublishes and deletes it, `archive` unpublishes and archives it, `unpublish` only unpublishes it and `abandon` only removes it from the Terraform state.",
+			},
 		},
 	}
 }
 
 func resourceCreateEntry(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 
 	fieldProperties := map[string]interface{}{}
 	rawField := d.Get("field").([]interface{})
@@ -95,7 +116,7 @@ func resourceCreateEntry(_ context.Context, d *schema.ResourceData, m interface{
 		},
 	}
 
-	err := client.Entries.Upsert(d.Get("space_id").(string), d.Get("contenttype_id").(string), entry)
+	err := upsertEntryWithMetadata(client, d.Get("space_id").(string), d.Get("contenttype_id").(string), entry, newMetadata(d.Get("tags").(*schema.Set)))
 	if err != nil {
 		return parseError(err)
 	}
@@ -114,10 +135,15 @@ func resourceCreateEntry(_ context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourceUpdateEntry(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	entryID := d.Id()
 
+	// The deletion settings are only used by Delete and already in the state
+	if !d.HasChangesExcept("deletion_policy", "prevent_delete_if_referenced") {
+		return nil
+	}
+
 	entry, err := client.Entries.Get(spaceID, entryID)
 	if err != nil {
 		return parseError(err)
@@ -134,7 +160,7 @@ func resourceUpdateEntry(_ context.Context, d *schema.ResourceData, m interface{
 	entry.Fields = fieldProperties
 	entry.Locale = d.Get("locale").(string)
 
-	err = client.Entries.Upsert(d.Get("space_id").(string), d.Get("contenttype_id").(string), entry)
+	err = upsertEntryWithMetadata(client, d.Get("space_id").(string), d.Get("contenttype_id").(string), entry, newMetadata(d.Get("tags").(*schema.Set)))
 	if err != nil {
 		return parseError(err)
 	}
@@ -153,7 +179,7 @@ func resourceUpdateEntry(_ context.Context, d *schema.ResourceData, m interface{
 }
 
 func setEntryState(d *schema.ResourceData, m interface{}) (err error) {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	entryID := d.Id()
 
@@ -175,36 +201,80 @@ func setEntryState(d *schema.ResourceData, m interface{}) (err error) {
 }
 
 func resourceReadEntry(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	entryID := d.Id()
 
-	entry, err := client.Entries.Get(spaceID, entryID)
+	entry, meta, err := getEntry(client, spaceID, entryID)
 	var notFoundError contentful.NotFoundError
 	if errors.As(err, &notFoundError) {
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return parseError(err)
+	}
+
 	err = setEntryProperties(d, entry)
 	if err != nil {
 		return parseError(err)
 	}
 
+	err = d.Set("tags", meta.tagIDs())
+	if err != nil {
+		return parseError(err)
+	}
+
 	return nil
 }
 
 func resourceDeleteEntry(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	entryID := d.Id()
+	policy := d.Get("deletion_policy").(string)
+
+	if policy == "abandon" {
+		return nil
+	}
+
+	entry, _, err := getEntry(client, spaceID, entryID)
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
 
-	_, err := client.Entries.Get(spaceID, entryID)
 	if err != nil {
 		return parseError(err)
 	}
 
-	err = client.Entries.Delete(spaceID, entryID)
+	if d.Get("prevent_delete_if_referenced").(bool) {
+		if diags := checkIncomingLinks(client, spaceID, "Entry", entryID); diags.HasError() {
+			return diags
+		}
+	}
+
+	if entry.Sys.PublishedAt != "" {
+		if err = client.Entries.Unpublish(spaceID, entry); err != nil {
+			return parseError(err)
+		}
+
+		// Unpublishing increments the version of the entry
+		if entry, _, err = getEntry(client, spaceID, entryID); err != nil {
+			return parseError(err)
+		}
+	}
+
+	switch policy {
+	case "archive":
+		if entry.Sys.ArchivedAt == "" {
+			err = client.Entries.Archive(spaceID, entry)
+		}
+	case "delete":
+		err = client.Entries.Delete(spaceID, entryID)
+	}
+
 	if err != nil {
 		return parseError(err)
 	}
@@ -212,6 +282,18 @@ func resourceDeleteEntry(_ context.Context, d *schema.ResourceData, m interface{
 	return nil
 }
 
+// getEntry fetches the entry and its metadata. EntriesService.Get of
+// contentful-go drops the error of the request, so the request is made here.
+func getEntry(client *contentful.Client, spaceID, entryID string) (*contentful.Entry, *metadata, error) {
+	var entry contentful.Entry
+	meta, err := getEntityWithMetadata(client, fmt.Sprintf("/spaces/%s/entries/%s", spaceID, entryID), &entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &entry, meta, nil
+}
+
 func setEntryProperties(d *schema.ResourceData, entry *contentful.Entry) (err error) {
 	if err = d.Set("space_id", entry.Sys.Space.Sys.ID); err != nil {
 		return err
@@ -1,8 +1,11 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,6 +23,60 @@ func TestParseContentValue_Json(t *testing.T) {
 	assert.Equal(t, parseContentValue(value), map[string]interface{}{"foo": "bar", "baz": []interface{}{float64(1), float64(2), float64(3)}})
 }
 
+func TestResourceUpdateEntryDeletionSettingsOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	r := resourceContentfulEntry()
+	state := &terraform.InstanceState{
+		ID: "entry-id",
+		Attributes: map[string]string{
+			"id":                           "entry-id",
+			"entry_id":                     "entry-id",
+			"version":                      "3",
+			"space_id":                     "space-id",
+			"contenttype_id":               "article",
+			"locale":                       "en-US",
+			"field.#":                      "1",
+			"field.0.id":                   "title",
+			"field.0.content":              "Hello",
+			"field.0.locale":               "en-US",
+			"published":                    "true",
+			"archived":                     "false",
+			"prevent_delete_if_referenced": "false",
+			"deletion_policy":              "delete",
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"entry_id":       "entry-id",
+		"space_id":       "space-id",
+		"contenttype_id": "article",
+		"locale":         "en-US",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "content": "Hello", "locale": "en-US"},
+		},
+		"published":                    true,
+		"archived":                     false,
+		"prevent_delete_if_referenced": true,
+		"deletion_policy":              "archive",
+	}), meta)
+	assert.NoError(t, err)
+	assert.Len(t, diff.Attributes, 2)
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "archive", newState.Attributes["deletion_policy"])
+	assert.Equal(t, "true", newState.Attributes["prevent_delete_if_referenced"])
+	assert.Equal(t, "3", newState.Attributes["version"])
+}
+
 func TestAccContentfulEntry_Basic(t *testing.T) {
 	var entry contentful.Entry
 
@@ -67,7 +124,7 @@ func testAccCheckContentfulEntryExists(n string, entry *contentful.Entry) resour
 			return fmt.Errorf("no contenttype_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		contentfulEntry, err := client.Entries.Get(spaceID, rs.Primary.ID)
 		if err != nil {
@@ -110,7 +167,7 @@ func testAccContentfulEntryDestroy(s *terraform.State) error {
 		}
 
 		// sdk client
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		entry, _ := client.Entries.Get(spaceID, rs.Primary.ID)
 		if entry == nil {
@@ -3,12 +3,50 @@ package contentful
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/contentful-go"
 )
 
+// environmentDetails holds the status and aliases of an environment, which
+// contentful-go does not expose.
+type environmentDetails struct {
+	Sys  environmentDetailsSys `json:"sys"`
+	Name string                `json:"name"`
+}
+
+type environmentDetailsSys struct {
+	ID                 string `json:"id"`
+	Version            int    `json:"version"`
+	Status             *link  `json:"status,omitempty"`
+	Aliases            []link `json:"aliases,omitempty"`
+	AliasedEnvironment *link  `json:"aliasedEnvironment,omitempty"`
+}
+
+func (e *environmentDetails) status() string {
+	if e.Sys.Status == nil {
+		return ""
+	}
+
+	return e.Sys.Status.Sys.ID
+}
+
+func (e *environmentDetails) aliasIDs() []string {
+	ids := []string{}
+	for _, alias := range e.Sys.Aliases {
+		ids = append(ids, alias.Sys.ID)
+	}
+
+	return ids
+}
+
 func resourceContentfulEnvironment() *schema.Resource {
 	return &schema.Resource{
 		Description:   "A Contentful Environment represents a space environment.",
@@ -17,7 +55,18 @@ func resourceContentfulEnvironment() *schema.Resource {
 		UpdateContext: resourceUpdateEnvironment,
 		DeleteContext: resourceDeleteEnvironment,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportEnvironment,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceContentfulEnvironmentV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceContentfulEnvironmentStateUpgradeV0,
+			},
 		},
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -28,101 +77,277 @@ func resourceContentfulEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the environment. Defaults to the name of the environment at creation, renaming the environment does not change its ID.",
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"source_environment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the environment or alias to clone the content from. Contentful clones `master` when not set.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Either `queued`, `ready` or `failed`.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevent the environment from being deleted. Environments listed in `protected_environments` of the provider are always protected.",
+			},
 		},
 	}
 }
 
-func resourceCreateEnvironment(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+func resourceCreateEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	name := d.Get("name").(string)
 
-	environment := &contentful.Environment{
-		Name: d.Get("name").(string),
+	environmentID := d.Get("environment_id").(string)
+	if environmentID == "" {
+		environmentID = name
 	}
 
-	err := client.Environments.Upsert(d.Get("space_id").(string), environment)
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID), nil, map[string]string{"name": name})
 	if err != nil {
 		return parseError(err)
 	}
 
-	if err := setEnvironmentProperties(d, environment); err != nil {
+	if source := d.Get("source_environment_id").(string); source != "" {
+		req.Header.Set("X-Contentful-Source-Environment", source)
+	}
+
+	var environment environmentDetails
+	if err = doRequest(req, &environment); err != nil {
 		return parseError(err)
 	}
 
-	d.SetId(environment.Name)
+	d.SetId(environment.Sys.ID)
 
-	return nil
+	if err = d.Set("environment_id", environment.Sys.ID); err != nil {
+		return parseError(err)
+	}
+
+	ready, diags := waitForEnvironment(ctx, client, spaceID, environment.Sys.ID, d.Timeout(schema.TimeoutCreate))
+	if ready != nil {
+		if err := setEnvironmentProperties(d, ready); err != nil {
+			return append(diags, parseError(err)...)
+		}
+	}
+
+	return diags
 }
 
 func resourceUpdateEnvironment(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	environmentID := d.Id()
 
-	environment, err := client.Environments.Get(spaceID, environmentID)
+	// deletion_protection is only used by Delete and already in the state
+	if !d.HasChangesExcept("deletion_protection") {
+		return nil
+	}
+
+	environment, err := getEnvironmentDetails(client, spaceID, environmentID)
 	if err != nil {
 		return parseError(err)
 	}
 
-	environment.Name = d.Get("name").(string)
-
-	err = client.Environments.Upsert(spaceID, environment)
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID), nil, map[string]string{"name": d.Get("name").(string)})
 	if err != nil {
 		return parseError(err)
 	}
 
-	if err := setEnvironmentProperties(d, environment); err != nil {
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(environment.Sys.Version))
+
+	if err = doRequest(req, environment); err != nil {
 		return parseError(err)
 	}
 
-	d.SetId(environment.Sys.ID)
+	if err := setEnvironmentProperties(d, environment); err != nil {
+		return parseError(err)
+	}
 
 	return nil
 }
 
 func resourceReadEnvironment(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	environmentID := d.Id()
 
-	environment, err := client.Environments.Get(spaceID, environmentID)
+	environment, err := getEnvironmentDetails(client, spaceID, environmentID)
 	var notFoundError contentful.NotFoundError
 	if errors.As(err, &notFoundError) {
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return parseError(err)
+	}
+
 	err = setEnvironmentProperties(d, environment)
 	if err != nil {
 		return parseError(err)
 	}
 
+	if err = setDeletionProtectionDefault(d, false); err != nil {
+		return parseError(err)
+	}
+
 	return nil
 }
 
 func resourceDeleteEnvironment(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	meta := m.(*providerMeta)
+	client := meta.client
 	spaceID := d.Get("space_id").(string)
 	environmentID := d.Id()
 
-	environment, err := client.Environments.Get(spaceID, environmentID)
+	if diags := checkDeletionProtection("environment", environmentID, d.Get("deletion_protection").(bool)); diags.HasError() {
+		return diags
+	}
+
+	environment, err := getEnvironmentDetails(client, spaceID, environmentID)
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
 	if err != nil {
 		return parseError(err)
 	}
 
-	err = client.Environments.Delete(spaceID, environment)
+	if diags := checkEnvironmentProtection(meta.protectedEnvironments, environmentID, environment.aliasIDs()); diags.HasError() {
+		return diags
+	}
+
+	req, err := newRequest(client, http.MethodDelete, fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID), nil, nil)
 	if err != nil {
 		return parseError(err)
 	}
 
-	return nil
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(environment.Sys.Version))
+
+	return parseError(doRequest(req, nil))
+}
+
+// waitForEnvironment waits until Contentful has finished cloning the content
+// into the environment. It returns the environment in its latest state, also
+// when cloning failed.
+func waitForEnvironment(ctx context.Context, client *contentful.Client, spaceID, environmentID string, timeout time.Duration) (*environmentDetails, diag.Diagnostics) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"queued"},
+		Target:  []string{"ready", "failed"},
+		Timeout: timeout,
+		Delay:   time.Second,
+		Refresh: func() (interface{}, string, error) {
+			environment, err := getEnvironmentDetails(client, spaceID, environmentID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return environment, environment.status(), nil
+		},
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, parseError(err)
+	}
+
+	environment := result.(*environmentDetails)
+	if environment.status() == "failed" {
+		return environment, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Environment %s failed to be created", environmentID),
+			Detail:   "Contentful could not copy the content of the source environment. Delete the environment and try again.",
+		}}
+	}
+
+	return environment, nil
+}
+
+// resourceImportEnvironment accepts `space_id:environment_id`
+func resourceImportEnvironment(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected space_id:environment_id", d.Id())
+	}
+
+	if err := d.Set("space_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("environment_id", parts[1]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("deletion_protection", false); err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
 }
 
-func setEnvironmentProperties(d *schema.ResourceData, environment *contentful.Environment) error {
-	if err := d.Set("space_id", environment.Sys.Space.Sys.ID); err != nil {
+// resourceContentfulEnvironmentV0 is the schema before environment_id was
+// added, when the ID was derived from the name at creation.
+func resourceContentfulEnvironmentV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceContentfulEnvironmentStateUpgradeV0 takes the environment_id from
+// the ID of the resource, which is the sys.id of the environment.
+func resourceContentfulEnvironmentStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	rawState["environment_id"] = rawState["id"]
+
+	return rawState, nil
+}
+
+func setEnvironmentProperties(d *schema.ResourceData, environment *environmentDetails) error {
+	if err := d.Set("environment_id", environment.Sys.ID); err != nil {
 		return err
 	}
 
@@ -134,5 +359,23 @@ func setEnvironmentProperties(d *schema.ResourceData, environment *contentful.En
 		return err
 	}
 
+	if err := d.Set("status", environment.status()); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func getEnvironmentDetails(client *contentful.Client, spaceID, environmentID string) (*environmentDetails, error) {
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var environment environmentDetails
+	if err := doRequest(req, &environment); err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
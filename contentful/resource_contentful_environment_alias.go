@@ -0,0 +1,236 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/contentful-go"
+)
+
+type environmentAlias struct {
+	Sys         *environmentAliasSys `json:"sys,omitempty"`
+	Environment link                 `json:"environment"`
+}
+
+type environmentAliasSys struct {
+	ID      string `json:"id,omitempty"`
+	Version int    `json:"version,omitempty"`
+}
+
+func resourceContentfulEnvironmentAlias() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Contentful Environment Alias points to an environment, so the environment can be switched without changing the alias used by clients. " +
+			"An existing alias such as `master` is taken over on create. Contentful does not allow deleting the `master` alias, destroying it only removes it from the state.",
+
+		CreateContext: resourceCreateEnvironmentAlias,
+		ReadContext:   resourceReadEnvironmentAlias,
+		UpdateContext: resourceUpdateEnvironmentAlias,
+		DeleteContext: resourceDeleteEnvironmentAlias,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportEnvironmentAlias,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the alias, e.g. `master`.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the environment the alias points to. Changing it switches the alias to the new environment in place.",
+			},
+		},
+	}
+}
+
+func resourceCreateEnvironmentAlias(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	aliasID := d.Get("alias_id").(string)
+
+	version := 0
+	existing, err := getEnvironmentAlias(client, spaceID, aliasID)
+	var notFoundError contentful.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		return parseError(err)
+	}
+
+	if existing != nil {
+		version = existing.Sys.Version
+	}
+
+	alias, err := putEnvironmentAlias(client, spaceID, aliasID, d.Get("environment_id").(string), version)
+	if err != nil {
+		return parseError(err)
+	}
+
+	d.SetId(alias.Sys.ID)
+
+	if err = setEnvironmentAliasProperties(d, alias); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceReadEnvironmentAlias(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	alias, err := getEnvironmentAlias(client, d.Get("space_id").(string), d.Id())
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return parseError(err)
+	}
+
+	// The target is always taken from Contentful, so an alias that was switched
+	// outside of Terraform shows up as a change in the next plan.
+	err = setEnvironmentAliasProperties(d, alias)
+	if err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceUpdateEnvironmentAlias(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+
+	existing, err := getEnvironmentAlias(client, spaceID, d.Id())
+	if err != nil {
+		return parseError(err)
+	}
+
+	alias, err := putEnvironmentAlias(client, spaceID, d.Id(), d.Get("environment_id").(string), existing.Sys.Version)
+	if err != nil {
+		return parseError(err)
+	}
+
+	if err = setEnvironmentAliasProperties(d, alias); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceDeleteEnvironmentAlias(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+
+	if d.Id() == "master" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The master alias was not deleted",
+			Detail:   "Contentful does not allow deleting the master alias, it has only been removed from the Terraform state.",
+		}}
+	}
+
+	req, err := newRequest(client, http.MethodDelete, fmt.Sprintf("/spaces/%s/environment_aliases/%s", spaceID, d.Id()), nil, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = doRequest(req, nil)
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	return parseError(err)
+}
+
+// resourceImportEnvironmentAlias accepts `space_id:alias_id`
+func resourceImportEnvironmentAlias(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected space_id:alias_id", d.Id())
+	}
+
+	if err := d.Set("space_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("alias_id", parts[1]); err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// putEnvironmentAlias creates the alias when version is 0, otherwise it points
+// the existing alias to the given environment.
+func putEnvironmentAlias(client *contentful.Client, spaceID, aliasID, environmentID string, version int) (*environmentAlias, error) {
+	alias := &environmentAlias{
+		Environment: newLink("Environment", environmentID),
+	}
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environment_aliases/%s", spaceID, aliasID), nil, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > 0 {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(version))
+	}
+
+	if err := doRequest(req, alias); err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+func getEnvironmentAlias(client *contentful.Client, spaceID, aliasID string) (*environmentAlias, error) {
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environment_aliases/%s", spaceID, aliasID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var alias environmentAlias
+	if err := doRequest(req, &alias); err != nil {
+		return nil, err
+	}
+
+	return &alias, nil
+}
+
+func setEnvironmentAliasProperties(d *schema.ResourceData, alias *environmentAlias) error {
+	if err := d.Set("version", alias.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("alias_id", alias.Sys.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("environment_id", alias.Environment.Sys.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
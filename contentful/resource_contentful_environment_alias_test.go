@@ -0,0 +1,167 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceImportEnvironmentAlias(t *testing.T) {
+	d := resourceContentfulEnvironmentAlias().TestResourceData()
+	d.SetId("space-id:master")
+
+	result, err := resourceImportEnvironmentAlias(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "master", result[0].Id())
+	assert.Equal(t, "space-id", result[0].Get("space_id"))
+	assert.Equal(t, "master", result[0].Get("alias_id"))
+
+	d = resourceContentfulEnvironmentAlias().TestResourceData()
+	d.SetId("master")
+
+	_, err = resourceImportEnvironmentAlias(context.Background(), d, nil)
+	assert.Error(t, err)
+}
+
+func TestResourceCreateEnvironmentAliasExisting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/spaces/space-id/environment_aliases/master", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "master", "version": 4}, "environment": {"sys": {"id": "blue"}}}`)
+		case http.MethodPut:
+			assert.Equal(t, "4", r.Header.Get("X-Contentful-Version"))
+
+			var body environmentAlias
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "green", body.Environment.Sys.ID)
+
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "master", "version": 5}, "environment": {"sys": {"id": "green"}}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	d := schema.TestResourceDataRaw(t, resourceContentfulEnvironmentAlias().Schema, map[string]interface{}{
+		"space_id":       "space-id",
+		"alias_id":       "master",
+		"environment_id": "green",
+	})
+
+	diags := resourceCreateEnvironmentAlias(context.Background(), d, &providerMeta{client: client})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "master", d.Id())
+	assert.Equal(t, 5, d.Get("version"))
+	assert.Equal(t, "green", d.Get("environment_id"))
+}
+
+func TestResourceReadEnvironmentAliasRepointed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"sys": {"id": "master", "version": 7}, "environment": {"sys": {"id": "blue"}}}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	d := schema.TestResourceDataRaw(t, resourceContentfulEnvironmentAlias().Schema, map[string]interface{}{
+		"space_id":       "space-id",
+		"alias_id":       "master",
+		"environment_id": "green",
+	})
+	d.SetId("master")
+
+	diags := resourceReadEnvironmentAlias(context.Background(), d, &providerMeta{client: client})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "blue", d.Get("environment_id"))
+	assert.Equal(t, 7, d.Get("version"))
+}
+
+func TestAccContentfulEnvironmentAlias_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulEnvironmentAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEnvironmentAliasConfig("blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_environment_alias.myalias", "alias_id", "provider-test"),
+					resource.TestCheckResourceAttr("contentful_environment_alias.myalias", "environment_id", "provider-test-blue"),
+				),
+			},
+			{
+				Config: testAccContentfulEnvironmentAliasConfig("green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_environment_alias.myalias", "environment_id", "provider-test-green"),
+				),
+			},
+			{
+				ResourceName:      "contentful_environment_alias.myalias",
+				ImportState:       true,
+				ImportStateId:     spaceID + ":provider-test",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContentfulEnvironmentAliasDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_environment_alias" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		_, err := getEnvironmentAlias(client, spaceID, rs.Primary.ID)
+		var notFoundError contentful.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		return fmt.Errorf("environment alias still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContentfulEnvironmentAliasConfig(target string) string {
+	return `
+resource "contentful_environment" "blue" {
+  space_id = "` + spaceID + `"
+  environment_id = "provider-test-blue"
+  name = "provider-test-blue"
+}
+
+resource "contentful_environment" "green" {
+  space_id = "` + spaceID + `"
+  environment_id = "provider-test-green"
+  name = "provider-test-green"
+}
+
+resource "contentful_environment_alias" "myalias" {
+  space_id = "` + spaceID + `"
+  alias_id = "provider-test"
+  environment_id = contentful_environment.` + target + `.environment_id
+}
+`
+}
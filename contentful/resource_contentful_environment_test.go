@@ -1,14 +1,118 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestResourceContentfulEnvironmentStateUpgradeV0(t *testing.T) {
+	state, err := resourceContentfulEnvironmentStateUpgradeV0(context.Background(), map[string]interface{}{
+		"id":       "staging",
+		"space_id": "space-id",
+		"name":     "Staging (renamed)",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", state["environment_id"])
+	assert.Equal(t, "Staging (renamed)", state["name"])
+}
+
+func TestResourceImportEnvironment(t *testing.T) {
+	d := resourceContentfulEnvironment().TestResourceData()
+	d.SetId("space-id:staging")
+
+	result, err := resourceImportEnvironment(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", result[0].Id())
+	assert.Equal(t, "space-id", result[0].Get("space_id"))
+	assert.Equal(t, "staging", result[0].Get("environment_id"))
+	assert.Equal(t, false, result[0].Get("deletion_protection"))
+
+	d = resourceContentfulEnvironment().TestResourceData()
+	d.SetId("staging")
+
+	_, err = resourceImportEnvironment(context.Background(), d, nil)
+	assert.Error(t, err)
+}
+
+func TestResourceUpdateEnvironmentDeletionProtectionOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	r := resourceContentfulEnvironment()
+	state := &terraform.InstanceState{
+		ID: "staging",
+		Attributes: map[string]string{
+			"id":                  "staging",
+			"version":             "2",
+			"space_id":            "space-id",
+			"environment_id":      "staging",
+			"name":                "Staging",
+			"status":              "ready",
+			"deletion_protection": "false",
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"space_id":            "space-id",
+		"environment_id":      "staging",
+		"name":                "Staging",
+		"deletion_protection": true,
+	}), meta)
+	assert.NoError(t, err)
+	assert.Len(t, diff.Attributes, 1)
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "true", newState.Attributes["deletion_protection"])
+}
+
+func TestWaitForEnvironment(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/spaces/space-id/environments/feature":
+			status := "queued"
+			if requests[r.URL.Path] > 1 {
+				status = "ready"
+			}
+			_, _ = fmt.Fprintf(w, `{"name": "feature", "sys": {"id": "feature", "version": 3, "status": {"sys": {"id": "%s"}}}}`, status)
+		case "/spaces/space-id/environments/broken":
+			_, _ = fmt.Fprint(w, `{"name": "broken", "sys": {"id": "broken", "status": {"sys": {"id": "failed"}}}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	environment, diags := waitForEnvironment(context.Background(), client, "space-id", "feature", time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "ready", environment.status())
+	assert.Equal(t, 3, environment.Sys.Version)
+
+	environment, diags = waitForEnvironment(context.Background(), client, "space-id", "broken", time.Minute)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Environment broken failed to be created", diags[0].Summary)
+	assert.Equal(t, "failed", environment.status())
+}
+
 func TestAccContentfulEnvironment_Basic(t *testing.T) {
 	var environment contentful.Environment
 
@@ -25,6 +129,8 @@ func TestAccContentfulEnvironment_Basic(t *testing.T) {
 						"space_id": spaceID,
 						"name":     "provider-test",
 					}),
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "status", "ready"),
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "environment_id", "provider-test"),
 				),
 			},
 			{
@@ -35,8 +141,16 @@ func TestAccContentfulEnvironment_Basic(t *testing.T) {
 						"space_id": spaceID,
 						"name":     "provider-test-updated",
 					}),
+					resource.TestCheckResourceAttr("contentful_environment.myenvironment", "environment_id", "provider-test"),
 				),
 			},
+			{
+				ResourceName:            "contentful_environment.myenvironment",
+				ImportState:             true,
+				ImportStateId:           spaceID + ":provider-test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_environment_id"},
+			},
 		},
 	})
 }
@@ -58,7 +172,7 @@ func testAccCheckContentfulEnvironmentExists(n string, environment *contentful.E
 			return fmt.Errorf("no name is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		contentfulEnvironment, err := client.Environments.Get(spaceID, rs.Primary.ID)
 		if err != nil {
@@ -97,7 +211,7 @@ func testAccContentfulEnvironmentDestroy(s *terraform.State) error {
 			return fmt.Errorf("no locale ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		_, err := client.Locales.Get(spaceID, localeID)
 		if _, ok := err.(contentful.NotFoundError); ok {
@@ -113,13 +227,17 @@ func testAccContentfulEnvironmentDestroy(s *terraform.State) error {
 var testAccContentfulEnvironmentConfig = `
 resource "contentful_environment" "myenvironment" {
   space_id = "` + spaceID + `"
+  environment_id = "provider-test"
   name = "provider-test"
+  source_environment_id = "master"
 }
 `
 
 var testAccContentfulEnvironmentUpdateConfig = `
 resource "contentful_environment" "myenvironment" {
   space_id = "` + spaceID + `"
+  environment_id = "provider-test"
   name = "provider-test-updated"
+  source_environment_id = "master"
 }
 `
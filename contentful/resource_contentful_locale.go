@@ -63,7 +63,7 @@ func resourceContentfulLocale() *schema.Resource {
 }
 
 func resourceCreateLocale(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 
 	locale := &contentful.Locale{
@@ -91,7 +91,7 @@ func resourceCreateLocale(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceReadLocale(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	localeID := d.Id()
 
@@ -115,7 +115,7 @@ func resourceReadLocale(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceUpdateLocale(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	localeID := d.Id()
 
@@ -145,7 +145,7 @@ func resourceUpdateLocale(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceDeleteLocale(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	localeID := d.Id()
 
@@ -69,7 +69,7 @@ func testAccCheckContentfulLocaleExists(n string, locale *contentful.Locale) res
 			return fmt.Errorf("no locale ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		contentfulLocale, err := client.Locales.Get(spaceID, localeID)
 		if err != nil {
@@ -134,7 +134,7 @@ func testAccContentfulLocaleDestroy(s *terraform.State) error {
 			return fmt.Errorf("no locale ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		_, err := client.Locales.Get(spaceID, localeID)
 
@@ -0,0 +1,340 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/contentful-go"
+)
+
+type release struct {
+	Sys      *releaseSys     `json:"sys,omitempty"`
+	Title    string          `json:"title"`
+	Entities releaseEntities `json:"entities"`
+}
+
+type releaseSys struct {
+	ID      string `json:"id,omitempty"`
+	Version int    `json:"version,omitempty"`
+}
+
+type releaseEntities struct {
+	Sys   releaseEntitiesSys `json:"sys"`
+	Items []link             `json:"items"`
+}
+
+type releaseEntitiesSys struct {
+	Type string `json:"type"`
+}
+
+type releaseAction struct {
+	Sys struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	} `json:"sys"`
+	Action string                    `json:"action"`
+	Error  *contentful.ErrorResponse `json:"error,omitempty"`
+}
+
+func resourceContentfulRelease() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Contentful Release groups entries and assets so they can be published together.",
+
+		CreateContext: resourceCreateRelease,
+		ReadContext:   resourceReadRelease,
+		UpdateContext: resourceUpdateRelease,
+		DeleteContext: resourceDeleteRelease,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment of the release. Defaults to the environment configured in the provider.",
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entity": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The entries and assets that are part of the release.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"Entry", "Asset"}, false)),
+							Description:      "The type of the entity, either `Entry` or `Asset`.",
+						},
+					},
+				},
+			},
+			"publish": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Publish all entities of the release whenever the release is created or changed, waiting for the release action to finish.",
+			},
+		},
+	}
+}
+
+func resourceCreateRelease(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	rel := buildRelease(d)
+
+	req, err := newRequest(client, http.MethodPost, fmt.Sprintf("/spaces/%s/environments/%s/releases", spaceID, environment), nil, rel)
+	if err != nil {
+		return parseError(err)
+	}
+
+	if err = doRequest(req, rel); err != nil {
+		return parseError(err)
+	}
+
+	d.SetId(rel.Sys.ID)
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = setReleaseProperties(d, rel); err != nil {
+		return parseError(err)
+	}
+
+	if d.Get("publish").(bool) {
+		return publishRelease(ctx, client, d, rel, d.Timeout(schema.TimeoutCreate))
+	}
+
+	return nil
+}
+
+func resourceReadRelease(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	rel, err := getRelease(client, d.Get("space_id").(string), d.Get("environment").(string), d.Id())
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = setReleaseProperties(d, rel)
+	if err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceUpdateRelease(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	rel, err := getRelease(client, spaceID, environment, d.Id())
+	if err != nil {
+		return parseError(err)
+	}
+
+	update := buildRelease(d)
+	update.Sys = rel.Sys
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", spaceID, environment, d.Id()), nil, update)
+	if err != nil {
+		return parseError(err)
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(rel.Sys.Version))
+
+	if err = doRequest(req, update); err != nil {
+		return parseError(err)
+	}
+
+	if err = setReleaseProperties(d, update); err != nil {
+		return parseError(err)
+	}
+
+	if d.Get("publish").(bool) {
+		if diags := publishRelease(ctx, client, d, update, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			// Keep the previous state, so the next apply publishes the release again
+			d.Partial(true)
+			return diags
+		}
+	}
+
+	return nil
+}
+
+func resourceDeleteRelease(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	req, err := newRequest(client, http.MethodDelete, fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", spaceID, environment, d.Id()), nil, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = doRequest(req, nil)
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	return parseError(err)
+}
+
+// publishRelease starts the publish release action and waits until Contentful
+// has finished processing it.
+func publishRelease(ctx context.Context, client *contentful.Client, d *schema.ResourceData, rel *release, timeout time.Duration) diag.Diagnostics {
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/published", spaceID, environment, rel.Sys.ID), nil, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(rel.Sys.Version))
+
+	var action releaseAction
+	if err = doRequest(req, &action); err != nil {
+		return parseError(err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"inProgress"},
+		Target:  []string{"succeeded"},
+		Timeout: timeout,
+		Delay:   time.Second,
+		Refresh: func() (interface{}, string, error) {
+			req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/actions/%s", spaceID, environment, rel.Sys.ID, action.Sys.ID), nil, nil)
+			if err != nil {
+				return nil, "", err
+			}
+
+			var current releaseAction
+			if err := doRequest(req, &current); err != nil {
+				return nil, "", err
+			}
+
+			if current.Sys.Status == "failed" {
+				if current.Error != nil {
+					return nil, current.Sys.Status, *current.Error
+				}
+
+				return nil, current.Sys.Status, fmt.Errorf("release action %s failed", current.Sys.ID)
+			}
+
+			return current, current.Sys.Status, nil
+		},
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return parseError(err)
+	}
+
+	// Publishing increments the version of the release
+	updated, err := getRelease(client, spaceID, environment, rel.Sys.ID)
+	if err != nil {
+		return parseError(err)
+	}
+
+	if err = setReleaseProperties(d, updated); err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func getRelease(client *contentful.Client, spaceID, environment, releaseID string) (*release, error) {
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", spaceID, environment, releaseID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rel release
+	if err := doRequest(req, &rel); err != nil {
+		return nil, err
+	}
+
+	return &rel, nil
+}
+
+func buildRelease(d *schema.ResourceData) *release {
+	rel := &release{
+		Title: d.Get("title").(string),
+		Entities: releaseEntities{
+			Sys:   releaseEntitiesSys{Type: "Array"},
+			Items: []link{},
+		},
+	}
+
+	for _, rawEntity := range d.Get("entity").([]interface{}) {
+		entity := rawEntity.(map[string]interface{})
+		rel.Entities.Items = append(rel.Entities.Items, newLink(entity["type"].(string), entity["id"].(string)))
+	}
+
+	return rel
+}
+
+func setReleaseProperties(d *schema.ResourceData, rel *release) error {
+	if err := d.Set("version", rel.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("title", rel.Title); err != nil {
+		return err
+	}
+
+	var entities []map[string]interface{}
+	for _, item := range rel.Entities.Items {
+		entities = append(entities, map[string]interface{}{
+			"id":   item.Sys.ID,
+			"type": item.Sys.LinkType,
+		})
+	}
+
+	if err := d.Set("entity", entities); err != nil {
+		return err
+	}
+
+	return nil
+}
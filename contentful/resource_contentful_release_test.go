@@ -0,0 +1,207 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUpdateReleasePublishFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/spaces/space-id/environments/master/releases/release-id":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "release-id", "version": 1}, "title": "Release", "entities": {"sys": {"type": "Array"}, "items": []}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/spaces/space-id/environments/master/releases/release-id":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "release-id", "version": 2}, "title": "Release updated", "entities": {"sys": {"type": "Array"}, "items": []}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/spaces/space-id/environments/master/releases/release-id/published":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "action-id", "status": "inProgress"}, "action": "publish"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/spaces/space-id/environments/master/releases/release-id/actions/action-id":
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "action-id", "status": "failed"}, "action": "publish"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+	meta := &providerMeta{client: client}
+
+	r := resourceContentfulRelease()
+	state := &terraform.InstanceState{
+		ID: "release-id",
+		Attributes: map[string]string{
+			"id":          "release-id",
+			"space_id":    "space-id",
+			"environment": "master",
+			"title":       "Release",
+			"publish":     "false",
+			"version":     "1",
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"space_id":    "space-id",
+		"environment": "master",
+		"title":       "Release updated",
+		"publish":     true,
+	}), meta)
+	assert.NoError(t, err)
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "false", newState.Attributes["publish"])
+	assert.Equal(t, "Release", newState.Attributes["title"])
+}
+
+func TestAccContentfulRelease_Basic(t *testing.T) {
+	var rel release
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulReleaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulReleaseExists("contentful_release.myrelease", &rel),
+					testAccCheckContentfulReleaseAttributes(&rel, map[string]interface{}{
+						"title":    "provider-test",
+						"entities": 1,
+					}),
+				),
+			},
+			{
+				Config: testAccContentfulReleaseUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulReleaseExists("contentful_release.myrelease", &rel),
+					testAccCheckContentfulReleaseAttributes(&rel, map[string]interface{}{
+						"title":    "provider-test-updated",
+						"entities": 0,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulReleaseExists(n string, rel *release) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		contentfulRelease, err := getRelease(client, spaceID, rs.Primary.Attributes["environment"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*rel = *contentfulRelease
+
+		return nil
+	}
+}
+
+func testAccCheckContentfulReleaseAttributes(rel *release, attrs map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		title := attrs["title"].(string)
+		if rel.Title != title {
+			return fmt.Errorf("release title does not match: %s, %s", rel.Title, title)
+		}
+
+		entities := attrs["entities"].(int)
+		if len(rel.Entities.Items) != entities {
+			return fmt.Errorf("release entities do not match: %d, %d", len(rel.Entities.Items), entities)
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulReleaseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_release" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no release ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		_, err := getRelease(client, spaceID, rs.Primary.Attributes["environment"], rs.Primary.ID)
+		var notFoundError contentful.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		return fmt.Errorf("release still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+var testAccContentfulReleaseConfig = `
+resource "contentful_asset" "myasset" {
+  asset_id = "test_release_asset"
+  locale = "en-US"
+  space_id = "` + spaceID + `"
+  fields {
+    title {
+      locale = "en-US"
+      content = "Asset title"
+    }
+    description {
+      locale = "en-US"
+      content = "Asset description"
+    }
+    file {
+      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+      file_name = "example.jpeg"
+      content_type = "image/jpeg"
+    }
+  }
+  published = false
+  archived = false
+}
+
+resource "contentful_release" "myrelease" {
+  space_id = "` + spaceID + `"
+  title = "provider-test"
+  entity {
+    id   = contentful_asset.myasset.id
+    type = "Asset"
+  }
+  publish = true
+}
+`
+
+var testAccContentfulReleaseUpdateConfig = `
+resource "contentful_release" "myrelease" {
+  space_id = "` + spaceID + `"
+  title = "provider-test-updated"
+}
+`
@@ -0,0 +1,266 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/contentful-go"
+)
+
+// scheduledAction is the scheduled action model including the sys.status
+// attribute, which the contentful-go model does not expose.
+type scheduledAction struct {
+	Sys          *scheduledActionSys `json:"sys,omitempty"`
+	Entity       link                `json:"entity"`
+	Environment  link                `json:"environment"`
+	ScheduledFor scheduledFor        `json:"scheduledFor"`
+	Action       string              `json:"action"`
+}
+
+type scheduledActionSys struct {
+	ID      string `json:"id,omitempty"`
+	Version int    `json:"version,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+type scheduledFor struct {
+	Datetime string `json:"datetime"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+func resourceContentfulScheduledAction() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Contentful Scheduled Action publishes or unpublishes an entry, asset or release at a given time.",
+
+		CreateContext: resourceCreateScheduledAction,
+		ReadContext:   resourceReadScheduledAction,
+		DeleteContext: resourceDeleteScheduledAction,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment of the entity. Defaults to the environment configured in the provider.",
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"entity_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"Entry", "Asset", "Release"}, false)),
+				Description:      "The type of the entity, one of `Entry`, `Asset` or `Release`.",
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"publish", "unpublish"}, false)),
+				Description:      "The action to perform, either `publish` or `unpublish`.",
+			},
+			"scheduled_for": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				DiffSuppressFunc: suppressEquivalentTime,
+				Description:      "The RFC3339 timestamp at which the action is executed.",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The IANA timezone used to display the scheduled time in the web app, e.g. `Europe/Berlin`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the action: `scheduled`, `succeeded`, `failed` or `canceled`.",
+			},
+		},
+	}
+}
+
+func resourceCreateScheduledAction(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+
+	environment := getEnvironment(d, client)
+
+	action := &scheduledAction{
+		Entity:      newLink(d.Get("entity_type").(string), d.Get("entity_id").(string)),
+		Environment: newLink("Environment", environment),
+		ScheduledFor: scheduledFor{
+			Datetime: d.Get("scheduled_for").(string),
+			Timezone: d.Get("timezone").(string),
+		},
+		Action: d.Get("action").(string),
+	}
+
+	req, err := newRequest(client, http.MethodPost, fmt.Sprintf("/spaces/%s/scheduled_actions", spaceID), nil, action)
+	if err != nil {
+		return parseError(err)
+	}
+
+	if err = doRequest(req, action); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = setScheduledActionProperties(d, action); err != nil {
+		return parseError(err)
+	}
+
+	d.SetId(action.Sys.ID)
+
+	return nil
+}
+
+func resourceReadScheduledAction(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	action, err := getScheduledAction(client, d.Get("space_id").(string), d.Get("environment").(string), d.Id())
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = setScheduledActionProperties(d, action)
+	if err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceDeleteScheduledAction(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	action, err := getScheduledAction(client, spaceID, environment, d.Id())
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	if err != nil {
+		return parseError(err)
+	}
+
+	// Only pending actions can be canceled, executed and canceled actions are
+	// kept by Contentful as history.
+	if action.Sys.Status != "scheduled" {
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("environment.sys.id", environment)
+
+	req, err := newRequest(client, http.MethodDelete, fmt.Sprintf("/spaces/%s/scheduled_actions/%s", spaceID, d.Id()), query, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = doRequest(req, nil)
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	return parseError(err)
+}
+
+func getScheduledAction(client *contentful.Client, spaceID, environment, actionID string) (*scheduledAction, error) {
+	query := url.Values{}
+	query.Set("environment.sys.id", environment)
+
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/scheduled_actions/%s", spaceID, actionID), query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var action scheduledAction
+	if err := doRequest(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+func setScheduledActionProperties(d *schema.ResourceData, action *scheduledAction) error {
+	if err := d.Set("version", action.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("status", action.Sys.Status); err != nil {
+		return err
+	}
+
+	if err := d.Set("entity_id", action.Entity.Sys.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("entity_type", action.Entity.Sys.LinkType); err != nil {
+		return err
+	}
+
+	if err := d.Set("action", action.Action); err != nil {
+		return err
+	}
+
+	if err := d.Set("scheduled_for", action.ScheduledFor.Datetime); err != nil {
+		return err
+	}
+
+	if err := d.Set("timezone", action.ScheduledFor.Timezone); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// suppressEquivalentTime ignores differences in the notation of timestamps,
+// Contentful returns them normalized to millisecond precision.
+func suppressEquivalentTime(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,164 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressEquivalentTime(t *testing.T) {
+	assert.True(t, suppressEquivalentTime("", "2030-01-01T10:00:00.000Z", "2030-01-01T10:00:00Z", nil))
+	assert.True(t, suppressEquivalentTime("", "2030-01-01T10:00:00.000Z", "2030-01-01T11:00:00+01:00", nil))
+	assert.False(t, suppressEquivalentTime("", "2030-01-01T10:00:00.000Z", "2030-01-01T10:30:00Z", nil))
+	assert.False(t, suppressEquivalentTime("", "", "2030-01-01T10:00:00Z", nil))
+}
+
+func TestAccContentfulScheduledAction_Basic(t *testing.T) {
+	var action scheduledAction
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulScheduledActionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulScheduledActionExists("contentful_scheduled_action.myaction", &action),
+					testAccCheckContentfulScheduledActionAttributes(&action, map[string]interface{}{
+						"entity_id": "mytestentry",
+						"action":    "publish",
+						"status":    "scheduled",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulScheduledActionExists(n string, action *scheduledAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		contentfulAction, err := getScheduledAction(client, spaceID, rs.Primary.Attributes["environment"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*action = *contentfulAction
+
+		return nil
+	}
+}
+
+func testAccCheckContentfulScheduledActionAttributes(action *scheduledAction, attrs map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		entityID := attrs["entity_id"].(string)
+		if action.Entity.Sys.ID != entityID {
+			return fmt.Errorf("scheduled action entity does not match: %s, %s", action.Entity.Sys.ID, entityID)
+		}
+
+		actionName := attrs["action"].(string)
+		if action.Action != actionName {
+			return fmt.Errorf("scheduled action does not match: %s, %s", action.Action, actionName)
+		}
+
+		status := attrs["status"].(string)
+		if action.Sys.Status != status {
+			return fmt.Errorf("scheduled action status does not match: %s, %s", action.Sys.Status, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulScheduledActionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_scheduled_action" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no scheduled action ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		action, err := getScheduledAction(client, spaceID, rs.Primary.Attributes["environment"], rs.Primary.ID)
+		var notFoundError contentful.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if action.Sys.Status == "scheduled" {
+			return fmt.Errorf("scheduled action is still pending with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccContentfulScheduledActionConfig = `
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "` + spaceID + `"
+  name = "tf_test_scheduled_action"
+  description = "Terraform Acc Test Content Type"
+  display_field = "field1"
+  field {
+    disabled  = false
+    id        = "field1"
+    localized = false
+    name      = "Field 1"
+    omitted   = false
+    required  = true
+    type      = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "mytestentry"
+  space_id = "` + spaceID + `"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale = "en-US"
+  field {
+    id = "field1"
+    content = "Hello, World!"
+    locale = "en-US"
+  }
+  published = false
+  archived  = false
+}
+
+resource "contentful_scheduled_action" "myaction" {
+  space_id      = "` + spaceID + `"
+  entity_id     = contentful_entry.myentry.id
+  entity_type   = "Entry"
+  action        = "publish"
+  scheduled_for = "2099-01-01T10:00:00Z"
+  timezone      = "Europe/Amsterdam"
+}
+`
@@ -3,6 +3,7 @@ package contentful
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +19,9 @@ func resourceContentfulSpace() *schema.Resource {
 		UpdateContext: resourceSpaceUpdate,
 		DeleteContext: resourceSpaceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSpaceImport,
 		},
+		CustomizeDiff: resourceSpaceCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -32,20 +34,32 @@ func resourceContentfulSpace() *schema.Resource {
 			},
 			// Space specific props
 			"default_locale": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "en",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The default locale of the space. Defaults to `en` when the space is created, otherwise the current default locale is kept. When changed after creation, the locale has to exist in the space already.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Prevent the space from being deleted. It has to be set to `false` and applied before the space can be destroyed.",
 			},
 		},
 	}
 }
 
 func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
+
+	defaultLocale := d.Get("default_locale").(string)
+	if defaultLocale == "" {
+		defaultLocale = "en"
+	}
 
 	space := &contentful.Space{
 		Name:          d.Get("name").(string),
-		DefaultLocale: d.Get("default_locale").(string),
+		DefaultLocale: defaultLocale,
 	}
 
 	err := client.Spaces.Upsert(space)
@@ -58,13 +72,17 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		return parseError(err)
 	}
 
+	if err = d.Set("default_locale", defaultLocale); err != nil {
+		return parseError(err)
+	}
+
 	d.SetId(space.Sys.ID)
 
 	return nil
 }
 
 func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Id()
 
 	_, err := client.Spaces.Get(spaceID)
@@ -74,37 +92,68 @@ func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, m interface{
 		return nil
 	}
 
-	return parseError(err)
-}
-
-func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
-	spaceID := d.Id()
-
-	space, err := client.Spaces.Get(spaceID)
 	if err != nil {
 		return parseError(err)
 	}
 
-	space.Name = d.Get("name").(string)
-
-	err = client.Spaces.Upsert(space)
-	if err != nil {
+	if err = setDeletionProtectionDefault(d, true); err != nil {
 		return parseError(err)
 	}
 
-	err = updateSpaceProperties(d, space)
+	locales, err := listLocales(client, spaceID, "master")
 	if err != nil {
 		return parseError(err)
 	}
 
+	if defaultLocale := findDefaultLocale(locales); defaultLocale != nil {
+		if err = d.Set("default_locale", defaultLocale.Code); err != nil {
+			return parseError(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Id()
+
+	if d.HasChange("name") {
+		space, err := client.Spaces.Get(spaceID)
+		if err != nil {
+			return parseError(err)
+		}
+
+		space.Name = d.Get("name").(string)
+
+		err = client.Spaces.Upsert(space)
+		if err != nil {
+			return parseError(err)
+		}
+
+		err = updateSpaceProperties(d, space)
+		if err != nil {
+			return parseError(err)
+		}
+	}
+
+	if d.HasChange("default_locale") {
+		if err := setSpaceDefaultLocale(client, spaceID, d.Get("default_locale").(string)); err != nil {
+			return parseError(err)
+		}
+	}
+
 	return nil
 }
 
 func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Id()
 
+	if diags := checkDeletionProtection("space", spaceID, d.Get("deletion_protection").(bool)); diags.HasError() {
+		return diags
+	}
+
 	space, err := client.Spaces.Get(spaceID)
 	if err != nil {
 		return parseError(err)
@@ -118,6 +167,81 @@ func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	return parseError(err)
 }
 
+// resourceSpaceImport enables deletion protection for imported spaces
+func resourceSpaceImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("deletion_protection", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceSpaceCustomizeDiff checks that a new default locale of an existing
+// space exists, since Contentful can only make an existing locale the default.
+func resourceSpaceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("default_locale") || !d.NewValueKnown("default_locale") {
+		return nil
+	}
+
+	client := m.(*providerMeta).client
+	code := d.Get("default_locale").(string)
+
+	locales, err := listLocales(client, d.Id(), "master")
+	if err != nil {
+		return err
+	}
+
+	if findLocale(locales, code) == nil {
+		return fmt.Errorf("locale %s does not exist in space %s, create it before making it the default locale", code, d.Id())
+	}
+
+	return nil
+}
+
+// setSpaceDefaultLocale makes the locale with the given code the default
+// locale of the space.
+func setSpaceDefaultLocale(client *contentful.Client, spaceID, code string) error {
+	locales, err := listLocales(client, spaceID, "master")
+	if err != nil {
+		return err
+	}
+
+	locale := findLocale(locales, code)
+	if locale == nil {
+		return fmt.Errorf("locale %s does not exist in space %s", code, spaceID)
+	}
+
+	if locale.Default {
+		return nil
+	}
+
+	// The default locale can not fall back to another locale
+	locale.Default = true
+	locale.FallbackCode = ""
+
+	return client.Locales.Upsert(spaceID, locale)
+}
+
+func findLocale(locales []*contentful.Locale, code string) *contentful.Locale {
+	for _, locale := range locales {
+		if locale.Code == code {
+			return locale
+		}
+	}
+
+	return nil
+}
+
+func findDefaultLocale(locales []*contentful.Locale) *contentful.Locale {
+	for _, locale := range locales {
+		if locale.Default {
+			return locale
+		}
+	}
+
+	return nil
+}
+
 func updateSpaceProperties(d *schema.ResourceData, space *contentful.Space) error {
 	err := d.Set("version", space.Sys.Version)
 	if err != nil {
@@ -1,14 +1,106 @@
 package contentful
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestResourceSpaceImport(t *testing.T) {
+	d := resourceContentfulSpace().TestResourceData()
+	d.SetId("space-id")
+
+	result, err := resourceSpaceImport(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "space-id", result[0].Id())
+	assert.Equal(t, true, result[0].Get("deletion_protection"))
+}
+
+func TestResourceSpaceCreateDefaultLocale(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/spaces", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "en", body["defaultLocale"])
+
+		_, _ = fmt.Fprint(w, `{"sys": {"id": "space-id", "version": 1}, "name": "Playground"}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	d := schema.TestResourceDataRaw(t, resourceContentfulSpace().Schema, map[string]interface{}{
+		"name": "Playground",
+	})
+
+	diags := resourceSpaceCreate(context.Background(), d, &providerMeta{client: client})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "space-id", d.Id())
+	assert.Equal(t, "en", d.Get("default_locale"))
+}
+
+func TestSetSpaceDefaultLocale(t *testing.T) {
+	updated := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/spaces/space-id/environments/master/locales":
+			_, _ = fmt.Fprint(w, `{"total": 2, "items": [
+				{"sys": {"id": "1", "version": 1, "createdAt": "2024-01-01T00:00:00Z"}, "name": "English", "code": "en-US", "default": true},
+				{"sys": {"id": "2", "version": 3, "createdAt": "2024-01-01T00:00:00Z"}, "name": "German", "code": "de", "fallbackCode": "en-US"}
+			]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/spaces/space-id/locales/2":
+			assert.Equal(t, "3", r.Header.Get("X-Contentful-Version"))
+
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, true, body["default"])
+			assert.NotContains(t, body, "fallbackCode")
+
+			updated = true
+			_, _ = fmt.Fprint(w, `{"sys": {"id": "2", "version": 4}, "name": "German", "code": "de", "default": true}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	assert.NoError(t, setSpaceDefaultLocale(client, "space-id", "en-US"))
+	assert.False(t, updated)
+
+	assert.NoError(t, setSpaceDefaultLocale(client, "space-id", "de"))
+	assert.True(t, updated)
+
+	err := setSpaceDefaultLocale(client, "space-id", "fr")
+	assert.EqualError(t, err, "locale fr does not exist in space space-id")
+}
+
+func TestFindDefaultLocale(t *testing.T) {
+	locales := []*contentful.Locale{
+		{Code: "de"},
+		{Code: "en-US", Default: true},
+	}
+
+	assert.Equal(t, "en-US", findDefaultLocale(locales).Code)
+	assert.Equal(t, "de", findLocale(locales, "de").Code)
+	assert.Nil(t, findLocale(locales, "fr"))
+	assert.Nil(t, findDefaultLocale(nil))
+}
+
 func TestAccContentfulSpace_Basic(t *testing.T) {
 	t.Skip() // Space resource can only be tested when user has the rights to do so, if not, skip this test!
 	resource.Test(t, resource.TestCase{
@@ -31,7 +123,7 @@ func TestAccContentfulSpace_Basic(t *testing.T) {
 }
 
 func testAccCheckContentfulSpaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*contentful.Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "contentful_space" {
@@ -50,11 +142,13 @@ func testAccCheckContentfulSpaceDestroy(s *terraform.State) error {
 var testAccContentfulSpaceConfig = `
 resource "contentful_space" "myspace" {
   name = "Playground"
+  deletion_protection = false
 }
 `
 
 var testAccContentfulSpaceUpdateConfig = `
 resource "contentful_space" "myspace" {
   name = "TF Acc Test Changed Space"
+  deletion_protection = false
 }
 `
@@ -0,0 +1,300 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/contentful-go"
+)
+
+type tag struct {
+	Sys  *tagSys `json:"sys,omitempty"`
+	Name string  `json:"name"`
+}
+
+type tagSys struct {
+	ID         string `json:"id,omitempty"`
+	Version    int    `json:"version,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func resourceContentfulTag() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Contentful Tag is used to label entries and assets in an environment.",
+
+		CreateContext: resourceCreateTag,
+		ReadContext:   resourceReadTag,
+		UpdateContext: resourceUpdateTag,
+		DeleteContext: resourceDeleteTag,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportTag,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment of the tag. Defaults to the environment configured in the provider.",
+			},
+			"tag_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"visibility": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "private",
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"public", "private"}, false)),
+				Description:      "Either `public` or `private`. Public tags are also returned by the Content Delivery API.",
+			},
+		},
+	}
+}
+
+func resourceCreateTag(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := getEnvironment(d, client)
+
+	t := &tag{
+		Sys: &tagSys{
+			ID:         d.Get("tag_id").(string),
+			Visibility: d.Get("visibility").(string),
+		},
+		Name: d.Get("name").(string),
+	}
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", spaceID, environment, t.Sys.ID), nil, t)
+	if err != nil {
+		return parseError(err)
+	}
+
+	if err = doRequest(req, t); err != nil {
+		return parseError(err)
+	}
+
+	if err = d.Set("environment", environment); err != nil {
+		return parseError(err)
+	}
+
+	if err = setTagProperties(d, t); err != nil {
+		return parseError(err)
+	}
+
+	d.SetId(t.Sys.ID)
+
+	return nil
+}
+
+func resourceReadTag(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	t, err := getTag(client, d.Get("space_id").(string), d.Get("environment").(string), d.Id())
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return parseError(err)
+	}
+
+	err = setTagProperties(d, t)
+	if err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceUpdateTag(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	t, err := getTag(client, spaceID, environment, d.Id())
+	if err != nil {
+		return parseError(err)
+	}
+
+	t.Name = d.Get("name").(string)
+
+	req, err := newRequest(client, http.MethodPut, fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", spaceID, environment, d.Id()), nil, t)
+	if err != nil {
+		return parseError(err)
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(t.Sys.Version))
+
+	if err = doRequest(req, t); err != nil {
+		return parseError(err)
+	}
+
+	err = setTagProperties(d, t)
+	if err != nil {
+		return parseError(err)
+	}
+
+	return nil
+}
+
+func resourceDeleteTag(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	spaceID := d.Get("space_id").(string)
+	environment := d.Get("environment").(string)
+
+	t, err := getTag(client, spaceID, environment, d.Id())
+	var notFoundError contentful.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	if err != nil {
+		return parseError(err)
+	}
+
+	if diags := checkTagReferences(client, spaceID, environment, d.Id()); diags.HasError() {
+		return diags
+	}
+
+	req, err := newRequest(client, http.MethodDelete, fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", spaceID, environment, d.Id()), nil, nil)
+	if err != nil {
+		return parseError(err)
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(t.Sys.Version))
+
+	return parseError(doRequest(req, nil))
+}
+
+// resourceImportTag accepts `space_id:tag_id` or `space_id:environment:tag_id`
+func resourceImportTag(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*providerMeta).client
+	parts := strings.Split(d.Id(), ":")
+
+	environment := client.Environment
+	switch len(parts) {
+	case 2:
+	case 3:
+		environment = parts[1]
+	default:
+		return nil, fmt.Errorf("invalid import ID %q, expected space_id:tag_id or space_id:environment:tag_id", d.Id())
+	}
+
+	tagID := parts[len(parts)-1]
+
+	if err := d.Set("space_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("environment", environment); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("tag_id", tagID); err != nil {
+		return nil, err
+	}
+
+	d.SetId(tagID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkTagReferences returns an error diagnostic when entries or assets still
+// have the tag applied, since removing it would silently change their metadata.
+func checkTagReferences(client *contentful.Client, spaceID, environment, tagID string) diag.Diagnostics {
+	query := url.Values{}
+	query.Set("metadata.tags.sys.id[in]", tagID)
+	query.Set("select", "sys.id")
+	query.Set("limit", "100")
+
+	var diags diag.Diagnostics
+	for _, entityType := range []string{"entries", "assets"} {
+		col, err := getCollection(client, fmt.Sprintf("/spaces/%s/environments/%s/%s", spaceID, environment, entityType), query)
+		if err != nil {
+			return parseError(err)
+		}
+
+		if col.Total == 0 {
+			continue
+		}
+
+		ids, err := collectionIDs(col)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		detail := strings.Join(ids, ", ")
+		if col.Total > len(ids) {
+			detail = fmt.Sprintf("%s and %d more", detail, col.Total-len(ids))
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Tag %s is still referenced by %d %s", tagID, col.Total, entityType),
+			Detail:   fmt.Sprintf("Remove the tag from the following %s before deleting it: %s", entityType, detail),
+		})
+	}
+
+	return diags
+}
+
+func getTag(client *contentful.Client, spaceID, environment, tagID string) (*tag, error) {
+	req, err := newRequest(client, http.MethodGet, fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", spaceID, environment, tagID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var t tag
+	if err := doRequest(req, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func setTagProperties(d *schema.ResourceData, t *tag) error {
+	if err := d.Set("version", t.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("tag_id", t.Sys.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", t.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("visibility", t.Sys.Visibility); err != nil {
+		return err
+	}
+
+	return nil
+}
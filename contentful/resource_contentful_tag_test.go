@@ -0,0 +1,189 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceImportTag(t *testing.T) {
+	client := contentful.NewCMA("token")
+	client.SetEnvironment("staging")
+
+	d := resourceContentfulTag().TestResourceData()
+	d.SetId("space-id:nature")
+
+	result, err := resourceImportTag(context.Background(), d, &providerMeta{client: client})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "nature", result[0].Id())
+	assert.Equal(t, "space-id", result[0].Get("space_id"))
+	assert.Equal(t, "staging", result[0].Get("environment"))
+
+	d = resourceContentfulTag().TestResourceData()
+	d.SetId("space-id:feature-branch:nature")
+
+	result, err = resourceImportTag(context.Background(), d, &providerMeta{client: client})
+	assert.NoError(t, err)
+	assert.Equal(t, "feature-branch", result[0].Get("environment"))
+	assert.Equal(t, "nature", result[0].Get("tag_id"))
+
+	d = resourceContentfulTag().TestResourceData()
+	d.SetId("nature")
+
+	_, err = resourceImportTag(context.Background(), d, &providerMeta{client: client})
+	assert.Error(t, err)
+}
+
+func TestCheckTagReferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "nature", r.URL.Query().Get("metadata.tags.sys.id[in]"))
+
+		switch r.URL.Path {
+		case "/spaces/space-id/environments/master/entries":
+			_, _ = fmt.Fprint(w, `{"total": 2, "items": [{"sys": {"id": "entry-1"}}, {"sys": {"id": "entry-2"}}]}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"total": 0, "items": []}`)
+		}
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	diags := checkTagReferences(client, "space-id", "master", "nature")
+	assert.True(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Tag nature is still referenced by 2 entries", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "entry-1, entry-2")
+}
+
+func TestAccContentfulTag_Basic(t *testing.T) {
+	var contentfulTag tag
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulTagConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulTagExists("contentful_tag.mytag", &contentfulTag),
+					testAccCheckContentfulTagAttributes(&contentfulTag, map[string]interface{}{
+						"name":       "provider-test",
+						"visibility": "public",
+					}),
+				),
+			},
+			{
+				Config: testAccContentfulTagUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulTagExists("contentful_tag.mytag", &contentfulTag),
+					testAccCheckContentfulTagAttributes(&contentfulTag, map[string]interface{}{
+						"name":       "provider-test-updated",
+						"visibility": "public",
+					}),
+				),
+			},
+			{
+				ResourceName:      "contentful_tag.mytag",
+				ImportState:       true,
+				ImportStateId:     spaceID + ":providertest",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulTagExists(n string, contentfulTag *tag) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		result, err := getTag(client, spaceID, rs.Primary.Attributes["environment"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*contentfulTag = *result
+
+		return nil
+	}
+}
+
+func testAccCheckContentfulTagAttributes(contentfulTag *tag, attrs map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		name := attrs["name"].(string)
+		if contentfulTag.Name != name {
+			return fmt.Errorf("tag name does not match: %s, %s", contentfulTag.Name, name)
+		}
+
+		visibility := attrs["visibility"].(string)
+		if contentfulTag.Sys.Visibility != visibility {
+			return fmt.Errorf("tag visibility does not match: %s, %s", contentfulTag.Sys.Visibility, visibility)
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulTagDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_tag" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+
+		_, err := getTag(client, spaceID, rs.Primary.Attributes["environment"], rs.Primary.ID)
+		var notFoundError contentful.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		return fmt.Errorf("tag still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+var testAccContentfulTagConfig = `
+resource "contentful_tag" "mytag" {
+  space_id = "` + spaceID + `"
+  tag_id = "providertest"
+  name = "provider-test"
+  visibility = "public"
+}
+`
+
+var testAccContentfulTagUpdateConfig = `
+resource "contentful_tag" "mytag" {
+  space_id = "` + spaceID + `"
+  tag_id = "providertest"
+  name = "provider-test-updated"
+  visibility = "public"
+}
+`
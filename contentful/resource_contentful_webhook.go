@@ -65,7 +65,7 @@ func resourceContentfulWebhook() *schema.Resource {
 }
 
 func resourceCreateWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 
 	webhook := &contentful.Webhook{
@@ -93,7 +93,7 @@ func resourceCreateWebhook(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceUpdateWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	webhookID := d.Id()
 
@@ -125,7 +125,7 @@ func resourceUpdateWebhook(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceReadWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	webhookID := d.Id()
 
@@ -149,7 +149,7 @@ func resourceReadWebhook(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceDeleteWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*contentful.Client)
+	client := m.(*providerMeta).client
 	spaceID := d.Get("space_id").(string)
 	webhookID := d.Id()
 
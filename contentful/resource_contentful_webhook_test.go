@@ -63,7 +63,7 @@ func testAccCheckContentfulWebhookExists(n string, webhook *contentful.Webhook)
 			return fmt.Errorf("no webhook ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		contentfulWebhook, err := client.Webhooks.Get(spaceID, rs.Primary.ID)
 		if err != nil {
@@ -119,7 +119,7 @@ func testAccContentfulWebhookDestroy(s *terraform.State) error {
 		}
 
 		// sdk client
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		_, err := client.Webhooks.Get(spaceID, rs.Primary.ID)
 		if _, ok := err.(contentful.NotFoundError); ok {
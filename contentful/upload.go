@@ -0,0 +1,90 @@
+package contentful
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/labd/contentful-go"
+)
+
+// uploadBaseURL derives the Upload API url from the Management API url of the
+// client, e.g. https://api.eu.contentful.com becomes https://upload.eu.contentful.com.
+// Any other url is used as is.
+func uploadBaseURL(client *contentful.Client) string {
+	u, err := url.Parse(client.BaseURL)
+	if err != nil || !strings.HasPrefix(u.Host, "api.") {
+		return client.BaseURL
+	}
+
+	u.Host = "upload." + strings.TrimPrefix(u.Host, "api.")
+
+	return u.String()
+}
+
+// createUpload streams the file at sourcePath to the Upload API and returns
+// the ID of the upload, which can be referenced from an asset with uploadFrom,
+// together with the checksum of the uploaded contents. The checksum is taken
+// from the same stream, so it matches the file that was uploaded even when the
+// file changes in the meantime.
+// contentful-go's ResourcesService.Create discards the response, so the
+// request is made here.
+func createUpload(client *contentful.Client, baseURL, spaceID, sourcePath string) (string, string, error) {
+	f, err := os.Open(sourcePath)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", "", err
+	}
+
+	h := sha256.New()
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/spaces/%s/uploads", strings.TrimSuffix(baseURL, "/"), spaceID), io.TeeReader(f, h))
+	if err != nil {
+		return "", "", err
+	}
+
+	for key, value := range client.Headers {
+		req.Header.Set(key, value)
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.ContentLength = info.Size()
+
+	var upload struct {
+		Sys linkSys `json:"sys"`
+	}
+	if err := doRequest(req, &upload); err != nil {
+		return "", "", err
+	}
+
+	if upload.Sys.ID == "" {
+		return "", "", fmt.Errorf("upload of %s did not return an upload ID", sourcePath)
+	}
+
+	return upload.Sys.ID, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fileHash returns the hex encoded SHA256 checksum of the file contents
+func fileHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
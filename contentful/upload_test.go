@@ -0,0 +1,102 @@
+package contentful
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labd/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadBaseURL(t *testing.T) {
+	client := contentful.NewCMA("token")
+	assert.Equal(t, "https://upload.contentful.com", uploadBaseURL(client))
+
+	client.BaseURL = "https://api.eu.contentful.com"
+	assert.Equal(t, "https://upload.eu.contentful.com", uploadBaseURL(client))
+
+	client.BaseURL = "http://127.0.0.1:8080"
+	assert.Equal(t, "http://127.0.0.1:8080", uploadBaseURL(client))
+}
+
+func TestFileHash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banner.png")
+	assert.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	hash, err := fileHash(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+
+	_, err = fileHash(filepath.Join(t.TempDir(), "missing.png"))
+	assert.Error(t, err)
+}
+
+func TestCreateUpload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banner.png")
+	assert.NoError(t, os.WriteFile(path, []byte("image contents"), 0o600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/spaces/space-id/uploads", r.URL.Path)
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, int64(14), r.ContentLength)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "image contents", string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"sys": {"id": "upload-id", "type": "Upload"}}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+
+	uploadID, hash, err := createUpload(client, server.URL, "space-id", path)
+	assert.NoError(t, err)
+	assert.Equal(t, "upload-id", uploadID)
+	assert.Equal(t, "9665359084eaabf70492a6bd53880ab863118fa64c44ef9e1623efcda6e81cfd", hash)
+}
+
+func TestCreateUpload_Error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banner.png")
+	assert.NoError(t, os.WriteFile(path, []byte("image contents"), 0o600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"sys": {"type": "Error", "id": "AccessTokenInvalid"}, "message": "The access token you sent could not be found or is invalid."}`)
+	}))
+	defer server.Close()
+
+	_, _, err := createUpload(contentful.NewCMA("token"), server.URL, "space-id", path)
+	assert.EqualError(t, err, "The access token you sent could not be found or is invalid.")
+}
+
+func TestSetAssetFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banner.png")
+	assert.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"sys": {"id": "upload-id", "type": "Upload"}}`)
+	}))
+	defer server.Close()
+
+	client := contentful.NewCMA("token")
+	client.BaseURL = server.URL
+
+	file := &contentful.File{}
+	hash, err := setAssetFileSource(client, "space-id", map[string]interface{}{"source_path": path, "upload": ""}, file)
+	assert.NoError(t, err)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+	assert.Equal(t, "upload-id", file.UploadFrom.Sys.ID)
+	assert.Equal(t, "Upload", file.UploadFrom.Sys.LinkType)
+
+	_, err = setAssetFileSource(client, "space-id", map[string]interface{}{"source_path": "", "upload": ""}, &contentful.File{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,9 @@
+data "contentful_api_keys" "website" {
+  space_id = "space-id"
+  name     = "Website"
+}
+
+output "website_delivery_token" {
+  value     = data.contentful_api_keys.website.api_keys[0].access_token
+  sensitive = true
+}
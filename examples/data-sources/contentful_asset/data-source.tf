@@ -0,0 +1,8 @@
+data "contentful_asset" "brand_logo" {
+  space_id = "space-id"
+  asset_id = "brand-logo"
+}
+
+output "brand_logo_url" {
+  value = data.contentful_asset.brand_logo.file[0].url
+}
@@ -0,0 +1,9 @@
+data "contentful_assets" "icons" {
+  space_id       = "space-id"
+  mimetype_group = "image"
+  tags           = ["icon"]
+}
+
+output "icon_urls" {
+  value = { for asset in data.contentful_assets.icons.assets : asset.id => asset.file[0].url }
+}
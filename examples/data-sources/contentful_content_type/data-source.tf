@@ -0,0 +1,11 @@
+data "contentful_content_type" "article" {
+  space_id        = "space-id"
+  content_type_id = "article"
+}
+
+check "article_has_slug" {
+  assert {
+    condition     = contains(data.contentful_content_type.article.field[*].id, "slug")
+    error_message = "The article content type must have a slug field."
+  }
+}
@@ -0,0 +1,27 @@
+data "contentful_entries" "homepage" {
+  space_id     = "space-id"
+  content_type = "page"
+  field_equals = {
+    slug = "home"
+  }
+}
+
+resource "contentful_entry" "navigation" {
+  entry_id       = "navigation"
+  space_id       = "space-id"
+  contenttype_id = "navigation"
+  locale         = "en-US"
+  field {
+    id     = "home"
+    locale = "en-US"
+    content = jsonencode({
+      sys = {
+        type     = "Link"
+        linkType = "Entry"
+        id       = data.contentful_entries.homepage.ids[0]
+      }
+    })
+  }
+  published = true
+  archived  = false
+}
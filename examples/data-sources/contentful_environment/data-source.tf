@@ -0,0 +1,8 @@
+data "contentful_environment" "staging" {
+  space_id       = "space-id"
+  environment_id = "staging"
+}
+
+output "staging_aliases" {
+  value = data.contentful_environment.staging.aliases
+}
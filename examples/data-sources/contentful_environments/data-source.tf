@@ -0,0 +1,13 @@
+data "contentful_environments" "all" {
+  space_id = "space-id"
+}
+
+# Create a tag in every environment that is ready to use
+resource "contentful_tag" "reviewed" {
+  for_each = { for environment in data.contentful_environments.all.environments : environment.id => environment if environment.status == "ready" }
+
+  space_id    = "space-id"
+  environment = each.key
+  tag_id      = "reviewed"
+  name        = "Reviewed"
+}
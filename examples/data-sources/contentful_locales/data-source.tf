@@ -0,0 +1,25 @@
+data "contentful_locales" "all" {
+  space_id = "space-id"
+}
+
+locals {
+  locale_codes = [for locale in data.contentful_locales.all.locales : locale.code]
+}
+
+resource "contentful_entry" "greeting" {
+  entry_id       = "greeting"
+  space_id       = "space-id"
+  contenttype_id = "greeting"
+  locale         = data.contentful_locales.all.default_locale
+
+  dynamic "field" {
+    for_each = local.locale_codes
+    content {
+      id      = "text"
+      locale  = field.value
+      content = "Hello (${field.value})"
+    }
+  }
+  published = true
+  archived  = false
+}
@@ -0,0 +1,7 @@
+data "contentful_space" "shared" {
+  space_id = "space-id"
+}
+
+output "shared_space_locales" {
+  value = data.contentful_space.shared.locales
+}
@@ -1,4 +1,7 @@
 provider "contentful" {
   cma_token       = "<YOUR_CMA_TOKEN>"
   organization_id = "<YOUR_ORGANIZATION_ID>"
+
+  # Environments and aliases that contentful_environment refuses to delete
+  protected_environments = ["master", "production"]
 }
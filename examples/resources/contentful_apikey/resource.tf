@@ -1,6 +1,15 @@
 resource "contentful_apikey" "myapikey" {
   space_id = "space-id"
 
-  name        = "api-key-name"
-  description = "a-great-key"
-}
\ No newline at end of file
+  name         = "api-key-name"
+  description  = "a-great-key"
+  environments = ["master", "staging"]
+
+  # Change to rotate the tokens, the previous API key is deleted by the next apply
+  rotation_trigger = "2024-07-01"
+}
+
+output "preview_token" {
+  value     = contentful_apikey.myapikey.preview_token
+  sensitive = true
+}
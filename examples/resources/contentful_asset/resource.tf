@@ -13,11 +13,38 @@ resource "contentful_asset" "example_asset" {
       content = "asset description"
     }
     file {
-      upload      = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      fileName    = "example.jpeg"
-      contentType = "image/jpeg"
+      upload       = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+      file_name    = "example.jpeg"
+      content_type = "image/jpeg"
     }
   }
+  tags      = ["brand"]
   published = false
   archived  = false
 }
+
+resource "contentful_asset" "example_local_asset" {
+  asset_id = "local_asset"
+  locale   = "en-US"
+  space_id = "space-id"
+
+  fields {
+    title {
+      locale  = "en-US"
+      content = "local asset title"
+    }
+    file {
+      source_path  = "${path.module}/images/banner.png"
+      file_name    = "banner.png"
+      content_type = "image/png"
+    }
+    file {
+      locale       = "de-DE"
+      source_path  = "${path.module}/images/banner-de.png"
+      file_name    = "banner-de.png"
+      content_type = "image/png"
+    }
+  }
+  published = true
+  archived  = false
+}
@@ -0,0 +1,30 @@
+# entries.yaml:
+#
+# navigation-home:
+#   content_type: navigationItem
+#   published: true
+#   fields:
+#     title:
+#       en-US: Home
+#       de-DE: Startseite
+#     url:
+#       en-US: /
+resource "contentful_entries" "navigation" {
+  space_id        = "space-id"
+  source_file     = "${path.module}/entries.yaml"
+  max_concurrency = 10
+}
+
+resource "contentful_entries" "feature_flags" {
+  space_id = "space-id"
+  content = jsonencode({
+    "flag-new-checkout" = {
+      content_type = "featureFlag"
+      published    = true
+      fields = {
+        name    = { "en-US" = "new-checkout" }
+        enabled = { "en-US" = true }
+      }
+    }
+  })
+}
@@ -35,7 +35,11 @@ resource "contentful_entry" "example_entry" {
       nodeType = "document"
     })
   }
-  published  = false
-  archived   = false
-  depends_on = [contentful_contenttype.mycontenttype]
+  tags            = ["news"]
+  deletion_policy = "archive"
+
+  prevent_delete_if_referenced = true
+  published                    = false
+  archived                     = false
+  depends_on                   = [contentful_contenttype.mycontenttype]
 }
@@ -0,0 +1,2 @@
+# Environments can be imported using space_id:environment_id
+terraform import contentful_environment.example_environment space-id:staging-2024-06
@@ -1,4 +1,10 @@
 resource "contentful_environment" "example_environment" {
-  space_id = "spaced-id"
-  name     = "environment-name"
-}
\ No newline at end of file
+  space_id              = "spaced-id"
+  environment_id        = "staging-2024-06"
+  name                  = "Staging"
+  source_environment_id = "master"
+
+  timeouts {
+    create = "20m"
+  }
+}
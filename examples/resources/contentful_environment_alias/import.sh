@@ -0,0 +1,2 @@
+# Environment aliases can be imported using space_id:alias_id
+terraform import contentful_environment_alias.master space-id:master
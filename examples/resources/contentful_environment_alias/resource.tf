@@ -0,0 +1,11 @@
+resource "contentful_environment" "blue" {
+  space_id       = "space-id"
+  environment_id = "release-2024-06"
+  name           = "Release 2024-06"
+}
+
+resource "contentful_environment_alias" "master" {
+  space_id       = "space-id"
+  alias_id       = "master"
+  environment_id = contentful_environment.blue.environment_id
+}
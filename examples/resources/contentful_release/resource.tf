@@ -0,0 +1,16 @@
+resource "contentful_release" "example_release" {
+  space_id = "space-id"
+  title    = "Spring campaign"
+
+  entity {
+    id   = contentful_entry.example_entry.id
+    type = "Entry"
+  }
+
+  entity {
+    id   = contentful_asset.example_asset.id
+    type = "Asset"
+  }
+
+  publish = true
+}
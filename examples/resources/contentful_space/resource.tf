@@ -1,3 +1,6 @@
 resource "contentful_space" "example_space" {
   name = "example_space_name"
-}
\ No newline at end of file
+
+  # Set to false and apply before destroying the space
+  deletion_protection = true
+}
@@ -0,0 +1,2 @@
+# Tags can be imported using space_id:tag_id or space_id:environment:tag_id
+terraform import contentful_tag.example_tag space-id:master:news
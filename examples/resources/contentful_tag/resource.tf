@@ -0,0 +1,6 @@
+resource "contentful_tag" "example_tag" {
+  space_id   = "space-id"
+  tag_id     = "news"
+  name       = "News"
+  visibility = "public"
+}